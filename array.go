@@ -0,0 +1,42 @@
+package copier
+
+import (
+	"reflect"
+)
+
+// ArrayMode represents the way src slice or array of different length is copied to dst array
+type ArrayMode int
+
+const (
+	// ArrayKeepRest copies shorter src onto first elements of dst array, the rest of dst array is kept.
+	// LengthMismatchError is returned if src is longer than dst array.
+	ArrayKeepRest ArrayMode = iota
+	// ArrayExactLength returns LengthMismatchError if src length differs from dst array length
+	ArrayExactLength
+	// ArrayTruncate copies only elements which fit dst array, the rest of dst array is zeroed
+	ArrayTruncate
+)
+
+func (c *Copier) copyToArray(st *state, dst, src reflect.Value) error {
+	length := src.Len()
+	if (length > dst.Len() && c.Arrays != ArrayTruncate) || (length != dst.Len() && c.Arrays == ArrayExactLength) {
+		return &LengthMismatchError{SrcLen: src.Len(), DstLen: dst.Len()}
+	}
+	if length > dst.Len() {
+		length = dst.Len()
+	}
+	array := reflect.New(dst.Type()).Elem()
+	if c.Arrays == ArrayKeepRest {
+		array.Set(dst)
+	}
+	for i := 0; i < length; i++ {
+		st.pushIndex(i)
+		err := c.copyInterface(st, array.Index(i), src.Index(i))
+		st.pop()
+		if err != nil {
+			return err
+		}
+	}
+	dst.Set(array)
+	return nil
+}
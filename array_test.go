@@ -0,0 +1,83 @@
+package copier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Copy_LongerSliceToArray(t *testing.T) {
+	type A struct {
+		Values []string
+	}
+	type B struct {
+		Values [2]string
+	}
+	var dst B
+	var src = A{Values: []string{"Lorem", "ipsum", "dolor"}}
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "Values: length mismatch: src length 3, dst length 2")
+	assert.True(t, errors.Is(err, ErrLengthMismatch))
+	var lengthErr *LengthMismatchError
+	assert.True(t, errors.As(err, &lengthErr))
+	assert.Equal(t, 3, lengthErr.SrcLen)
+	assert.Equal(t, 2, lengthErr.DstLen)
+	assert.Equal(t, B{}, dst)
+}
+
+func Test_Copy_ShorterSliceToArray(t *testing.T) {
+	var dst = [4]byte{6, 7, 8, 9}
+	var src = []byte{1, 2, 3}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, [4]byte{1, 2, 3, 9}, dst)
+}
+
+func Test_Copy_ShorterSliceToArrayExactLength(t *testing.T) {
+	var dst [3]int
+	var src = []int{1}
+	copier := New()
+	copier.Arrays = ArrayExactLength
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "length mismatch: src length 1, dst length 3")
+}
+
+func Test_Copy_LongerSliceToArrayTruncate(t *testing.T) {
+	var dst [2]int
+	var src = []string{"100", "200", "300"}
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	copier.Arrays = ArrayTruncate
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, [2]int{100, 200}, dst)
+}
+
+func Test_Copy_ShorterArrayToArrayTruncate(t *testing.T) {
+	var dst = [3]string{"Lorem", "ipsum", "dolor"}
+	var src = [1]string{"amet"}
+	copier := New()
+	copier.Arrays = ArrayTruncate
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, [3]string{"amet"}, dst)
+}
+
+func Test_Copy_ArrayToArrayError(t *testing.T) {
+	var dst = [2]int{1, 2}
+	var src = [2]string{"100", "Lorem"}
+	err := Copy(&dst, &src, StringToIntConverter)
+	assert.EqualError(t, err, `[1]: strconv.Atoi: parsing "Lorem": invalid syntax`)
+	assert.Equal(t, [2]int{1, 2}, dst)
+}
+
+func Test_Copy_ArrayToSliceAppend(t *testing.T) {
+	var dst = []string{"Lorem"}
+	var src = [2]string{"ipsum", "dolor"}
+	copier := New()
+	copier.Slices = SliceAppend
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Lorem", "ipsum", "dolor"}, dst)
+}
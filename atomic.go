@@ -0,0 +1,77 @@
+package copier
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// atomics stores clone functions of atomic types by reflect.Type, nil function means plain assignment
+var atomics sync.Map
+
+// atomicsVersion is incremented by RegisterAtomic, so copiers drop plans compiled before registration
+var atomicsVersion atomic.Uint64
+
+func init() {
+	RegisterAtomic[time.Time](nil)
+	RegisterAtomic[primitive.ObjectID](nil)
+	RegisterAtomic[primitive.Decimal128](nil)
+	RegisterAtomic(func(v big.Int) big.Int {
+		var res big.Int
+		res.Set(&v)
+		return res
+	})
+	RegisterAtomic(func(v big.Float) big.Float {
+		var res big.Float
+		res.Copy(&v)
+		return res
+	})
+	RegisterAtomic(func(v big.Rat) big.Rat {
+		var res big.Rat
+		res.Set(&v)
+		return res
+	})
+}
+
+// RegisterAtomic registers T as atomic type: its values are assigned as a whole or cloned with clone function
+// if it's not nil, instead of being copied field by field. Atomic structs are not flattened when embedded
+// and not converted to maps. Registration drops cached struct info and copy plans of all copiers,
+// so it shouldn't run concurrently with copying.
+// time.Time, big.Int, big.Float, big.Rat, primitive.ObjectID and primitive.Decimal128 are registered by default.
+func RegisterAtomic[T any](clone func(T) T) {
+	var fn func(reflect.Value) reflect.Value
+	if clone != nil {
+		fn = func(v reflect.Value) reflect.Value {
+			return reflect.ValueOf(clone(v.Interface().(T)))
+		}
+	}
+	atomics.Store(reflect.TypeOf((*T)(nil)).Elem(), fn)
+	resetStructInfo()
+	atomicsVersion.Add(1)
+}
+
+// isAtomic reports whether t is registered atomic type
+func isAtomic(t reflect.Type) bool {
+	_, ok := atomics.Load(t)
+	return ok
+}
+
+// copyAtomic assigns or clones src value to dst, if src or dst is atomic type.
+// Values of different types are converted if ConvertNamedTypes allows it.
+func (c *Copier) copyAtomic(dst, src reflect.Value) error {
+	if src.Type() != dst.Type() && !c.canConvertNamed(dst.Type(), src.Type()) {
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, src.Type(), dst.Type())
+	}
+	if fn, ok := atomics.Load(src.Type()); ok {
+		if clone := fn.(func(reflect.Value) reflect.Value); clone != nil {
+			src = clone(src)
+		}
+	}
+	dst.Set(src.Convert(dst.Type()))
+	return nil
+}
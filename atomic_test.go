@@ -0,0 +1,163 @@
+package copier
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testEvent struct {
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+type testEventDTO struct {
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+}
+
+func Test_Copy_StructWithTime(t *testing.T) {
+	created := time.Date(2023, 5, 1, 10, 0, 0, 0, time.FixedZone("EEST", 3*60*60))
+	updated := created.Add(time.Hour)
+	var dst testEventDTO
+	var src = testEvent{Name: "Lorem", CreatedAt: created, UpdatedAt: &updated}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.True(t, created.Equal(dst.CreatedAt))
+	assert.Equal(t, created.Location(), dst.CreatedAt.Location())
+	assert.True(t, updated.Equal(*dst.UpdatedAt))
+	assert.NotSame(t, src.UpdatedAt, dst.UpdatedAt)
+}
+
+func Test_Copy_EmbeddedTime(t *testing.T) {
+	type A struct {
+		time.Time
+		Name string
+	}
+	type B struct {
+		Time time.Time
+		Name string
+	}
+	now := time.Now()
+	var dst B
+	var src = A{Time: now, Name: "Lorem"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Time: now, Name: "Lorem"}, dst)
+}
+
+func Test_Copy_TimeToMap(t *testing.T) {
+	now := time.Now()
+	var dst map[string]interface{}
+	var src = testEvent{Name: "Lorem", CreatedAt: now}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, now, dst["CreatedAt"])
+}
+
+func Test_Copy_BigNumbersAreCloned(t *testing.T) {
+	type A struct {
+		Int   *big.Int
+		Float *big.Float
+		Rat   big.Rat
+	}
+	var dst A
+	var src = A{Int: big.NewInt(100), Float: big.NewFloat(1.5), Rat: *big.NewRat(1, 3)}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	src.Int.SetInt64(200)
+	src.Float.SetFloat64(2.5)
+	src.Rat.SetInt64(5)
+	assert.Equal(t, int64(100), dst.Int.Int64())
+	assert.Equal(t, "1.5", dst.Float.String())
+	assert.Equal(t, "1/3", dst.Rat.String())
+}
+
+type testMoney struct {
+	cents int64
+}
+
+// unregisterAtomic removes T registered by test from atomic types when test finishes
+func unregisterAtomic[T any](t *testing.T) {
+	t.Cleanup(func() {
+		atomics.Delete(reflect.TypeOf((*T)(nil)).Elem())
+		resetStructInfo()
+		atomicsVersion.Add(1)
+	})
+}
+
+func Test_Copy_RegisteredAtomic(t *testing.T) {
+	cloned := 0
+	RegisterAtomic(func(v testMoney) testMoney {
+		cloned++
+		return v
+	})
+	unregisterAtomic[testMoney](t)
+	type A struct {
+		Price testMoney
+	}
+	type B struct {
+		Price testMoney
+	}
+	var dst B
+	var src = A{Price: testMoney{cents: 100}}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Price: testMoney{cents: 100}}, dst)
+	assert.Equal(t, 1, cloned)
+}
+
+type testTime time.Time
+
+func Test_Copy_AtomicToNamedType(t *testing.T) {
+	now := time.Now()
+	var dst testTime
+	copier := New()
+	copier.ConvertNamedTypes = true
+	err := copier.Copy(&dst, &now)
+	assert.ErrorIs(t, err, ErrDifferentTypes)
+
+	copier.NamedConversions = []NamedConversion{{Src: time.Time{}, Dst: testTime{}}}
+	err = copier.Copy(&dst, &now)
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(time.Time(dst)))
+}
+
+func Test_Copy_AtomicToOtherStruct(t *testing.T) {
+	type A struct {
+		Wall int
+	}
+	var dst A
+	var src = time.Now()
+	err := Copy(&dst, &src)
+	assert.ErrorIs(t, err, ErrDifferentTypes)
+}
+
+type testVersion struct {
+	major int
+	Label string
+}
+
+func Test_Copy_RegisterAtomicAfterCopy(t *testing.T) {
+	type A struct {
+		Version testVersion
+	}
+	var src = A{Version: testVersion{major: 2, Label: "Lorem"}}
+	copier := New()
+	var dst A
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, A{Version: testVersion{Label: "Lorem"}}, dst)
+
+	RegisterAtomic[testVersion](nil)
+	unregisterAtomic[testVersion](t)
+	dst = A{}
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+}
@@ -0,0 +1,88 @@
+package copier
+
+import (
+	"testing"
+)
+
+type benchItemA struct {
+	Name  string
+	Price string
+	Tags  []string
+}
+
+type benchItemB struct {
+	Name  string
+	Price int
+	Tags  []string
+}
+
+type benchOrderA struct {
+	ID       string
+	Customer string
+	Items    []benchItemA
+	Meta     map[string]string
+}
+
+type benchOrderB struct {
+	ID       int
+	Customer string
+	Items    []benchItemB
+	Meta     map[string]string
+}
+
+func newBenchOrder() benchOrderA {
+	return benchOrderA{
+		ID:       "100",
+		Customer: "Jonh",
+		Items: []benchItemA{
+			{Name: "Lorem", Price: "100", Tags: []string{"a", "b"}},
+			{Name: "Ipsum", Price: "200", Tags: []string{"c"}},
+			{Name: "Dolor", Price: "300"},
+		},
+		Meta: map[string]string{"source": "web", "lang": "en"},
+	}
+}
+
+func Benchmark_Copy_StructCachedPlans(b *testing.B) {
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	src := newBenchOrder()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var dst benchOrderB
+		if err := copier.Copy(&dst, &src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// Benchmark_CopyFunc_WithConverters measures package level Copy, which reuses copier of the same converters
+func Benchmark_CopyFunc_WithConverters(b *testing.B) {
+	src := newBenchOrder()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var dst benchOrderB
+		if err := Copy(&dst, &src, StringToIntConverter); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func Benchmark_Copy_SliceOfStructsCachedPlans(b *testing.B) {
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	src := make([]benchOrderA, 100)
+	for i := range src {
+		src[i] = newBenchOrder()
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var dst []benchOrderB
+		if err := copier.Copy(&dst, &src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package copier
+
+import (
+	"fmt"
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	bsonMType   = reflect.TypeOf(primitive.M{})
+	bsonDType   = reflect.TypeOf(primitive.D{})
+	bsonRawType = reflect.TypeOf(bson.Raw{})
+)
+
+// isDocument reports whether t is BSON document type, which keys are matched by bson tags
+func isDocument(t reflect.Type) bool {
+	return t == bsonMType || t == bsonDType
+}
+
+// mapFields returns fields of struct info with their keys in map of mapType.
+// Keys are resolved with resolver if it's set, otherwise bson tags are used for documents.
+func mapFields(info *structInfo, mapType reflect.Type, resolver FieldNameResolver) []namedField {
+	return resolveFields(info, func(field fieldInfo) (string, bool) {
+		switch {
+		case field.Unexported, field.Embedded:
+			return "", false
+		case resolver != nil:
+			return resolveName(field, resolver)
+		case isDocument(mapType):
+			return field.BSONName, field.BSONName != ""
+		default:
+			return field.Name, true
+		}
+	})
+}
+
+// isRawTarget reports whether bson.Raw is decoded to copy it to dst type
+func isRawTarget(dst reflect.Type) bool {
+	switch dst.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Interface:
+		return true
+	default:
+		return dst == bsonDType
+	}
+}
+
+// documentToMap converts ordered document to primitive.M, the last value of duplicated key wins
+func documentToMap(src reflect.Value) reflect.Value {
+	doc := src.Interface().(primitive.D)
+	m := make(primitive.M, len(doc))
+	for _, e := range doc {
+		m[e.Key] = e.Value
+	}
+	return reflect.ValueOf(m)
+}
+
+// copyRaw decodes BSON document to primitive.D and copies it to dst
+func (c *Copier) copyRaw(st *state, dst, src reflect.Value) error {
+	var doc primitive.D
+	if err := bson.Unmarshal(src.Bytes(), &doc); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSource, err)
+	}
+	return c.copyInterface(st, dst, reflect.ValueOf(doc))
+}
+
+// copyStructToDocument copies struct fields to new primitive.D in order of fields with keys from bson tags.
+// Nested structs are copied to primitive.D too.
+func (c *Copier) copyStructToDocument(st *state, dst, src reflect.Value) error {
+	fields := mapFields(getStructInfo(src.Type()), bsonDType, c.SrcFieldNames)
+	doc := make(primitive.D, 0, len(fields))
+	for _, named := range fields {
+		field := named.field
+		value, ok := fieldByIndex(src, field.Index)
+		if !ok || c.skipMerge(value, field.OmitEmpty) {
+			continue
+		}
+		st.pushField(field.Name)
+		errCount := len(st.errs)
+		value, err := c.copyMapValue(st, bsonDType, interfaceType, value, reflect.Value{})
+		st.pop()
+		if err != nil {
+			return err
+		}
+		if len(st.errs) > errCount {
+			continue
+		}
+		doc = append(doc, primitive.E{Key: named.name, Value: value.Interface()})
+	}
+	dst.Set(reflect.ValueOf(doc))
+	return nil
+}
@@ -0,0 +1,157 @@
+package copier
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testOrderItem struct {
+	SKU   string `bson:"sku"`
+	Count int    `bson:"qty"`
+}
+
+type testOrder struct {
+	ID        string          `bson:"_id"`
+	Customer  string          `bson:"customer_name"`
+	Total     int             // lowercased "total" key
+	CreatedAt time.Time       `bson:"created_at"`
+	Items     []testOrderItem `bson:"items"`
+	Internal  string          `bson:"-"`
+}
+
+func Test_Copy_BSONMapToStruct(t *testing.T) {
+	id := primitive.NewObjectID()
+	created := time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC)
+	var src = primitive.M{
+		"_id":           id,
+		"customer_name": "Jonh",
+		"total":         100,
+		"created_at":    created,
+		"items":         primitive.A{primitive.M{"sku": "Lorem", "qty": 2}},
+		"Internal":      "skipped",
+	}
+	var dst testOrder
+	copier := New()
+	copier.SetConverters([]Converter{*ObjectIDToStringConverter})
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, testOrder{
+		ID:        id.Hex(),
+		Customer:  "Jonh",
+		Total:     100,
+		CreatedAt: created,
+		Items:     []testOrderItem{{SKU: "Lorem", Count: 2}},
+	}, dst)
+}
+
+func Test_Copy_BSONDocumentToStruct(t *testing.T) {
+	var src = primitive.D{
+		{Key: "_id", Value: "100"},
+		{Key: "customer_name", Value: "Jonh"},
+		{Key: "items", Value: primitive.A{primitive.D{{Key: "sku", Value: "Lorem"}, {Key: "qty", Value: 2}}}},
+	}
+	var dst testOrder
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, testOrder{ID: "100", Customer: "Jonh", Items: []testOrderItem{{SKU: "Lorem", Count: 2}}}, dst)
+}
+
+func Test_Copy_BSONRawToStruct(t *testing.T) {
+	id := primitive.NewObjectID()
+	raw, err := bson.Marshal(primitive.M{
+		"_id":           id,
+		"customer_name": "Jonh",
+		"total":         int32(100),
+		"items":         primitive.A{primitive.M{"sku": "Lorem", "qty": int32(2)}},
+	})
+	assert.NoError(t, err)
+	var dst testOrder
+	copier := New()
+	copier.ConvertNumbers = true
+	copier.SetConverters(MongoConverters())
+	err = copier.Copy(&dst, (*bson.Raw)(&raw))
+	assert.NoError(t, err)
+	assert.Equal(t, testOrder{ID: id.Hex(), Customer: "Jonh", Total: 100, Items: []testOrderItem{{SKU: "Lorem", Count: 2}}}, dst)
+}
+
+func Test_Copy_InvalidBSONRaw(t *testing.T) {
+	var dst testOrder
+	var src = bson.Raw{1, 2, 3}
+	err := Copy(&dst, &src)
+	assert.True(t, errors.Is(err, ErrInvalidSource))
+}
+
+func Test_Copy_StructToBSONMap(t *testing.T) {
+	var dst primitive.M
+	var src = testOrder{ID: "100", Customer: "Jonh", Items: []testOrderItem{{SKU: "Lorem", Count: 2}}, Internal: "skipped"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, primitive.M{
+		"_id":           "100",
+		"customer_name": "Jonh",
+		"total":         0,
+		"created_at":    time.Time{},
+		"items":         []testOrderItem{{SKU: "Lorem", Count: 2}},
+	}, dst)
+}
+
+func Test_Copy_StructToBSONDocument(t *testing.T) {
+	type Address struct {
+		City string `bson:"city"`
+	}
+	type User struct {
+		Name    string  `bson:"name"`
+		Address Address `bson:"address"`
+		Skipped string  `bson:"-"`
+	}
+	var dst primitive.D
+	var src = User{Name: "Jonh", Address: Address{City: "Kyiv"}, Skipped: "Lorem"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, primitive.D{
+		{Key: "name", Value: "Jonh"},
+		{Key: "address", Value: primitive.D{{Key: "city", Value: "Kyiv"}}},
+	}, dst)
+
+	var back User
+	err = Copy(&back, &dst)
+	assert.NoError(t, err)
+	assert.Equal(t, User{Name: "Jonh", Address: Address{City: "Kyiv"}}, back)
+}
+
+func Test_Copy_BSONRawToBytes(t *testing.T) {
+	type A struct {
+		R bson.Raw
+	}
+	type B struct {
+		R []byte
+	}
+	raw, err := bson.Marshal(primitive.M{"name": "Jonh"})
+	assert.NoError(t, err)
+	var dst B
+	var src = A{R: raw}
+	copier := New()
+	copier.ConvertNamedTypes = true
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte(raw), dst.R)
+
+	var doc A
+	err = Copy(&doc, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, src, doc)
+}
+
+func Test_Copy_BSONRawToInterface(t *testing.T) {
+	raw, err := bson.Marshal(primitive.M{"name": "Jonh"})
+	assert.NoError(t, err)
+	var dst interface{}
+	err = Copy(&dst, (*bson.Raw)(&raw))
+	assert.NoError(t, err)
+	assert.Equal(t, primitive.D{{Key: "name", Value: "Jonh"}}, dst)
+}
@@ -1,6 +1,7 @@
 package copier
 
 import (
+	"fmt"
 	"reflect"
 )
 
@@ -11,10 +12,41 @@ type Converter struct {
 	Src     interface{}
 	Dst     interface{}
 	Convert func(src interface{}) (interface{}, error)
+
+	srcType reflect.Type
+	dstType reflect.Type
+}
+
+// NewConverter creates Converter from typed convert function.
+// Src and Dst types are derived from S and D, Convert returns error if value is not S.
+func NewConverter[S, D any](convert func(src S) (D, error)) Converter {
+	var src S
+	var dst D
+	srcType := reflect.TypeOf((*S)(nil)).Elem()
+	return Converter{
+		Src: src,
+		Dst: dst,
+		Convert: func(value interface{}) (interface{}, error) {
+			typed, ok := value.(S)
+			if !ok && (value != nil || srcType.Kind() != reflect.Interface) {
+				return nil, fmt.Errorf("value is not %s: %T", srcType, value)
+			}
+			res, err := convert(typed)
+			if err != nil {
+				return nil, err
+			}
+			return res, nil
+		},
+		srcType: srcType,
+		dstType: reflect.TypeOf((*D)(nil)).Elem(),
+	}
 }
 
 // SrcType returns reflect.Type of Src or interfaceType if src is nil
 func (p *Converter) SrcType() reflect.Type {
+	if p.srcType != nil {
+		return p.srcType
+	}
 	if p.Src == nil {
 		return interfaceType
 	}
@@ -23,6 +55,9 @@ func (p *Converter) SrcType() reflect.Type {
 
 // DstType returns reflect.Type of Dst or interfaceType if dst is nil
 func (p *Converter) DstType() reflect.Type {
+	if p.dstType != nil {
+		return p.dstType
+	}
 	if p.Dst == nil {
 		return interfaceType
 	}
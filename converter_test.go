@@ -2,7 +2,9 @@ package copier
 
 import (
 	"errors"
+	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,6 +35,66 @@ func Test_Converter_DstType_nil(t *testing.T) {
 	assert.Equal(t, interfaceType, typ)
 }
 
+func Test_NewConverter_Types(t *testing.T) {
+	converter := NewConverter(func(src int) (string, error) {
+		return strconv.Itoa(src), nil
+	})
+	assert.Equal(t, reflect.TypeOf(0), converter.SrcType())
+	assert.Equal(t, reflect.TypeOf(""), converter.DstType())
+}
+
+func Test_NewConverter_InterfaceTypes(t *testing.T) {
+	converter := NewConverter(func(src fmt.Stringer) (error, error) {
+		return errors.New(src.String()), nil
+	})
+	assert.Equal(t, reflect.TypeOf((*fmt.Stringer)(nil)).Elem(), converter.SrcType())
+	assert.Equal(t, reflect.TypeOf((*error)(nil)).Elem(), converter.DstType())
+}
+
+func Test_NewConverter_ConvertInvalid(t *testing.T) {
+	converter := NewConverter(func(src int) (string, error) {
+		return strconv.Itoa(src), nil
+	})
+	result, err := converter.Convert("100")
+	assert.Equal(t, errors.New("value is not int: string"), err)
+	assert.Equal(t, nil, result)
+}
+
+func Test_NewConverter_ConvertError(t *testing.T) {
+	converter := NewConverter(func(src string) (int, error) {
+		return 0, errors.New("error text")
+	})
+	result, err := converter.Convert("100")
+	assert.EqualError(t, err, "error text")
+	assert.Equal(t, nil, result)
+}
+
+func Test_NewConverter_ConvertNilInterface(t *testing.T) {
+	converter := NewConverter(func(src interface{}) (bool, error) {
+		return src == nil, nil
+	})
+	result, err := converter.Convert(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, true, result)
+}
+
+func Test_NewConverter_Copy(t *testing.T) {
+	type Celsius float64
+	type A struct {
+		Temperature float64
+	}
+	type B struct {
+		Temperature Celsius
+	}
+	var dst B
+	var src = A{Temperature: 36.6}
+	err := Copy(&dst, &src, NewConverter(func(src float64) (Celsius, error) {
+		return Celsius(src), nil
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, B{Temperature: 36.6}, dst)
+}
+
 func Test_IntToStringConverter_ConvertInt(t *testing.T) {
 	result, err := IntToStringConverter.Convert(100)
 	assert.NoError(t, err)
@@ -110,7 +172,7 @@ func Test_StringToObjectIDConverter_ConvertEmptyString(t *testing.T) {
 
 func Test_StringToObjectIDConverter_ConvertInvalidString(t *testing.T) {
 	result, err := StringToObjectIDConverter.Convert("invalid")
-	assert.Equal(t, errors.New("failed get ObjectID from string: encoding/hex: invalid byte: U+0069 'i'"), err)
+	assert.Equal(t, errors.New("failed get ObjectID from string: the provided hex string is not a valid ObjectID"), err)
 	assert.Equal(t, nil, result)
 }
 
@@ -6,6 +6,9 @@ import (
 	"log"
 	"os"
 	"reflect"
+	"sync"
+	"sync/atomic"
+	"unsafe"
 )
 
 var (
@@ -17,14 +20,90 @@ var (
 	ErrDifferentTypes = errors.New("src and dst fields has different types")
 	// ErrCannotSetValue represents error can not set value
 	ErrCannotSetValue = errors.New("can not set value")
+	// ErrRequiredField represents error required field has no matching field
+	ErrRequiredField = errors.New("required field not found")
+	// ErrFieldNotFound represents error src field has no matching dst field
+	ErrFieldNotFound = errors.New("field not found")
+	// ErrNumberOverflow represents error converted number doesn't fit dst type
+	ErrNumberOverflow = errors.New("number overflow")
+	// ErrPrecisionLoss represents error float number has fractional part which can't be copied to integer
+	ErrPrecisionLoss = errors.New("number precision loss")
+	// ErrMaxDepth represents error copied value is nested deeper than Copier.MaxDepth
+	ErrMaxDepth = errors.New("max depth exceeded")
+	// ErrLengthMismatch represents error src length doesn't match dst array length
+	ErrLengthMismatch = errors.New("length mismatch")
+	// ErrCycle represents error cycle of pointers is copied to map, which can't represent it
+	ErrCycle = errors.New("pointer cycle")
+)
+
+// UnmatchedFieldPolicy represents behavior of Copier when src struct field has no matching dst field
+type UnmatchedFieldPolicy int
+
+const (
+	// UnmatchedFieldLog logs unmatched field via Copier.Logger and continues copying
+	UnmatchedFieldLog UnmatchedFieldPolicy = iota
+	// UnmatchedFieldIgnore silently skips unmatched field and continues copying
+	UnmatchedFieldIgnore
+	// UnmatchedFieldReport copies all matched fields and returns FieldNotFoundError with every unmatched field
+	UnmatchedFieldReport
+	// UnmatchedFieldFail stops copying and returns FieldNotFoundError with the first unmatched field
+	UnmatchedFieldFail
 )
 
 // Copier represents struct of Copier
 type Copier struct {
-	Converters []Converter
-	Logger     *log.Logger
+	Converters      []Converter
+	Logger          *log.Logger
+	UnmatchedFields UnmatchedFieldPolicy
+	// AggregateErrors enables copying of all values regardless of errors,
+	// Copy returns MultiError with every failed path.
+	AggregateErrors bool
+	// ConvertNumbers enables conversion between all int, uint and float kinds
+	ConvertNumbers bool
+	// NumberOverflow defines behavior of number conversion when value doesn't fit dst type
+	NumberOverflow OverflowPolicy
+	// AllowPrecisionLoss allows conversion of float with fractional part to integer by truncation
+	AllowPrecisionLoss bool
+	// ConvertNamedTypes enables copying between types with the same underlying type,
+	// e.g. `type Status string` and string
+	ConvertNamedTypes bool
+	// Implementations defines concrete types used to populate dst values of interface types,
+	// by default dst value gets the dynamic type of src value
+	Implementations []Implementation
+	// CopyUnexported enables copying of unexported struct fields between values of the same type,
+	// e.g. for deep clones. Unexported fields are skipped by default.
+	CopyUnexported bool
+	// Slices defines how src slice is copied to existing dst slice
+	Slices SliceMode
+	// EmptySlices defines how nil and empty src slices are copied with SliceReplace mode
+	EmptySlices EmptySlicePolicy
+	// Arrays defines how src of different length is copied to dst array
+	Arrays ArrayMode
+	// Merge defines which src struct fields are skipped to keep dst values, e.g. for partial updates.
+	// Fields tagged with `copier:",omitempty"` are skipped if src value is zero regardless of Merge.
+	Merge MergePolicy
+	// MaxDepth limits nesting of copied values, zero means no limit.
+	// Cycles of pointers are preserved without limit, MaxDepth protects from other deep or cyclic values.
+	// Cycles of pointers copied from structs to maps can't be preserved and fail with ErrCycle.
+	MaxDepth int
+	// Maps defines how src map entries are copied to existing dst map
+	Maps MapMode
+	// NamedConversions lists allowed conversions between two distinct named types,
+	// which are blocked even if ConvertNamedTypes is enabled
+	NamedConversions []NamedConversion
+	// SrcFieldNames and DstFieldNames resolve names used to match fields of src and dst structs
+	// with fields of other structs and with map keys, Go field names or bson tags of documents are used
+	// if they are nil. Names from copier tags take precedence over them.
+	SrcFieldNames FieldNameResolver
+	DstFieldNames FieldNameResolver
+
+	plans    sync.Map
+	settings atomic.Pointer[planSettings]
 }
 
+// defaultCopier is used by Copy function when no converters are given
+var defaultCopier = New()
+
 // New creates new Copier
 func New() *Copier {
 	defaultLogger := log.New(os.Stderr, "", log.LstdFlags)
@@ -36,127 +115,235 @@ func New() *Copier {
 // SetConverters set converters to copier
 func (c *Copier) SetConverters(cc []Converter) {
 	c.Converters = cc
+	c.resetPlans()
+}
+
+// SetFieldNameResolvers set resolvers of src and dst struct field names to copier
+func (c *Copier) SetFieldNameResolvers(src, dst FieldNameResolver) {
+	c.SrcFieldNames = src
+	c.DstFieldNames = dst
+	c.resetPlans()
+}
+
+// maxConverterCopiers limits number of copiers cached for distinct sets of converters
+const maxConverterCopiers = 64
+
+var (
+	// converterCopiers contains copiers used by Copy with converters by converterSetKey
+	converterCopiers     sync.Map
+	converterCopiersSize atomic.Int64
+)
+
+// converterSetKey represents key of cached copier: the first converter and number of converters
+type converterSetKey struct {
+	first converterKey
+	count int
 }
 
-// Copy create new copier, set converters and make copy value from source to destination
+// Copy make copy value from source to destination with copier of given converters.
+// Copiers are shared between calls with the same converters, so copy plans are reused.
 func Copy(dst, src interface{}, cc ...Converter) error {
+	return copierWith(cc).Copy(dst, src)
+}
+
+// copierWith returns default copier if no converters are given or cached copier with converters
+func copierWith(cc []Converter) *Copier {
+	if len(cc) == 0 {
+		return defaultCopier
+	}
+	key := converterSetKey{first: newConverterKey(&cc[0]), count: len(cc)}
+	if cached, ok := converterCopiers.Load(key); ok {
+		copier := cached.(*Copier)
+		if s := copier.settings.Load(); s != nil && s.matchesConverters(cc) {
+			return copier
+		}
+	}
 	copier := New()
-	copier.SetConverters(cc)
-	return copier.Copy(dst, src)
+	// converters are copied, so changes of cc by caller don't affect cached copier
+	copier.SetConverters(append([]Converter(nil), cc...))
+	if _, ok := converterCopiers.Load(key); ok || converterCopiersSize.Load() < maxConverterCopiers {
+		if !ok {
+			converterCopiersSize.Add(1)
+		}
+		converterCopiers.Store(key, copier)
+	}
+	return copier
 }
 
 // Copy make copy value from source to destination
 func (c *Copier) Copy(dst, src interface{}) error {
 	d := reflect.ValueOf(dst)
-	if d.Kind() != reflect.Ptr {
+	if d.Kind() != reflect.Ptr || d.IsNil() {
 		return ErrInvalidDestination
 	}
 	s := reflect.ValueOf(src)
-	if s.Kind() != reflect.Ptr {
+	if s.Kind() != reflect.Ptr || s.IsNil() {
 		return ErrInvalidSource
 	}
-	return c.copyInterface(d, s.Elem())
+	c.checkPlans()
+	st := &state{}
+	err := c.copyInterface(st, d.Elem(), s.Elem())
+	if err != nil {
+		return err
+	}
+	if len(st.unmatched) > 0 {
+		err = &FieldNotFoundError{Paths: st.unmatched}
+		if !c.AggregateErrors {
+			return err
+		}
+		st.errs = append(st.errs, err)
+	}
+	if len(st.errs) > 0 {
+		return &MultiError{Errors: st.errs}
+	}
+	return nil
 }
 
-func (c *Copier) copyInterface(dst, src reflect.Value) error {
-	if src.Kind() == reflect.Ptr && src.IsNil() {
+// copyInterface copies src to dst, where dst is settable value of destination type
+func (c *Copier) copyInterface(st *state, dst, src reflect.Value) error {
+	if src.Kind() == reflect.Interface && src.IsNil() {
 		return nil
 	}
-	for _, pattern := range c.Converters {
-		dstElem := dst
-		if dst.Kind() == reflect.Ptr {
-			dstElem = dst.Elem()
-		}
-		if pattern.SrcType() == src.Type() && pattern.DstType() == dstElem.Type() {
-			res, err := pattern.Convert(src.Interface())
-			if err != nil {
-				return err
-			}
-			dstElem.Set(reflect.ValueOf(res))
-			return nil
-		}
+	if src.Kind() == reflect.Ptr && src.IsNil() && c.plan(dst.Type(), src.Type()).kind != planConvert {
+		// nil pointers are skipped unless converter of pointer type handles them
+		return nil
 	}
-	switch src.Kind() {
-	case reflect.Slice, reflect.Array:
-		return c.copySliceArray(dst, src)
-	case reflect.Map:
-		return c.copyMap(dst, src)
-	case reflect.Struct:
-		return c.copyStruct(dst, src)
-	case reflect.Ptr:
-		return c.copyPtr(dst, src)
-	default:
-		return c.copyElement(dst, src)
+	if c.MaxDepth > 0 && st.depth >= c.MaxDepth {
+		return c.fail(st, st.wrap(fmt.Errorf("%w: %d", ErrMaxDepth, c.MaxDepth), dst.Type(), src.Type()))
 	}
+	st.depth++
+	err := c.copyValue(st, dst, src)
+	st.depth--
+	return err
 }
 
-func (c *Copier) copyPtr(dst, src reflect.Value) error {
-	switch {
-	case dst.Kind() != reflect.Ptr && !dst.CanAddr():
-		return fmt.Errorf("%s: expected %s, actual %s", ErrDifferentTypes, src.Kind(), dst.Kind())
-	case dst.Kind() != reflect.Ptr:
-		return c.copyInterface(dst.Addr(), src.Elem())
-	case dst.IsNil():
-		newElem := reflect.New(reflect.TypeOf(dst.Interface()).Elem())
-		dst.Set(newElem)
-		return c.copyInterface(newElem, src.Elem())
+// copyValue copies src to dst according to copy plan of their types
+func (c *Copier) copyValue(st *state, dst, src reflect.Value) error {
+	p := c.plan(dst.Type(), src.Type())
+	var err error
+	switch p.kind {
+	case planConvert:
+		err = c.convert(p.converter, dst, src)
+	case planPtr:
+		err = c.copyPtr(st, dst, src)
+	case planDstPtr:
+		err = c.copyToPtr(st, dst, src)
+	case planInterface:
+		return c.copyInterface(st, dst, src.Elem())
+	case planDstInterface:
+		err = c.copyToInterface(st, dst, src)
+	case planSliceArray:
+		err = c.copySliceArray(st, dst, src)
+	case planMap:
+		err = c.copyMap(st, dst, src)
+	case planStruct:
+		err = c.copyStruct(st, p, dst, src)
+	case planMapToStruct:
+		err = c.copyMapToStruct(st, dst, src)
+	case planStructToMap:
+		err = c.copyStructToMap(st, dst, src)
+	case planAtomic:
+		err = c.copyAtomic(dst, src)
+	case planDocument:
+		err = c.copyInterface(st, dst, documentToMap(src))
+	case planRaw:
+		err = c.copyRaw(st, dst, src)
+	case planStructToDocument:
+		err = c.copyStructToDocument(st, dst, src)
 	default:
-		return c.copyInterface(dst.Elem(), src.Elem())
+		err = c.copyElement(st, dst, src)
+	}
+	if err != nil {
+		return c.fail(st, st.wrap(err, dst.Type(), src.Type()))
+	}
+	return nil
+}
+
+// fail returns err or collects it if AggregateErrors is enabled
+func (c *Copier) fail(st *state, err error) error {
+	if c.AggregateErrors {
+		st.errs = append(st.errs, err)
+		return nil
+	}
+	return err
+}
+
+func (c *Copier) convert(converter *Converter, dst, src reflect.Value) error {
+	res, err := converter.Convert(src.Interface())
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
 	}
+	dst.Set(reflect.ValueOf(res))
+	return nil
 }
 
-func (c *Copier) copyMap(dst, src reflect.Value) error {
-	dstElem := dst
-	if dst.Kind() == reflect.Ptr {
-		dstElem = dst.Elem()
+func (c *Copier) copyPtr(st *state, dst, src reflect.Value) error {
+	if dst.Kind() != reflect.Ptr {
+		return c.copyInterface(st, dst, src.Elem())
 	}
-	if src.Kind() != dstElem.Kind() {
-		return fmt.Errorf("%s: expected %s, actual %s", ErrDifferentTypes, src.Kind(), dstElem.Kind())
+	if ptr, ok := st.visit(dst, src); ok {
+		dst.Set(ptr)
+		return nil
 	}
-	dstValueType := reflect.TypeOf(dstElem.Interface()).Elem()
-	srcValueType := reflect.TypeOf(src.Interface()).Elem()
-	srcKeyType := reflect.TypeOf(src.Interface()).Key()
-	dstKeyType := reflect.TypeOf(dstElem.Interface()).Key()
-	if dstElem.IsNil() {
-		dstElem.Set(reflect.MakeMapWithSize(dstElem.Type(), src.Len()))
+	if dst.IsNil() {
+		dst.Set(reflect.New(dst.Type().Elem()))
 	}
-	for _, key := range src.MapKeys() {
-		dstKey := key
-		if srcKeyType.Kind() != dstKeyType.Kind() {
-			newKey := reflect.New(dstKeyType)
-			err := c.copyInterface(newKey, key)
+	st.remember(dst.Elem().Addr(), src)
+	return c.copyInterface(st, dst.Elem(), src.Elem())
+}
+
+func (c *Copier) copyToPtr(st *state, dst, src reflect.Value) error {
+	if dst.IsNil() {
+		newElem := reflect.New(dst.Type().Elem())
+		err := c.copyInterface(st, newElem.Elem(), src)
+		if err != nil {
+			return err
+		}
+		dst.Set(newElem)
+		return nil
+	}
+	return c.copyInterface(st, dst.Elem(), src)
+}
+
+func (c *Copier) copyStruct(st *state, p *plan, dst, src reflect.Value) error {
+	if dst.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, src.Kind(), dst.Kind())
+	}
+	if p.unexported && c.CopyUnexported && !src.CanAddr() {
+		addressable := reflect.New(src.Type()).Elem()
+		addressable.Set(src)
+		src = addressable
+	}
+	for _, field := range p.fields {
+		if field.unexported && !c.CopyUnexported {
+			continue
+		}
+		st.pushField(field.src.Name)
+		if !field.matched {
+			err := c.unmatchedField(st, field.src)
 			if err != nil {
-				return err
+				err = c.fail(st, err)
 			}
-			dstKey = newKey.Elem()
-		}
-		dstValue := src.MapIndex(key)
-		if srcValueType.Kind() != dstValueType.Kind() {
-			newValue := reflect.New(dstValueType)
-			err := c.copyInterface(newValue, dstValue)
+			st.pop()
 			if err != nil {
 				return err
 			}
-			dstValue = newValue.Elem()
-		}
-		dstElem.SetMapIndex(dstKey, dstValue)
-	}
-	return nil
-}
-
-func (c *Copier) copyStruct(dst, src reflect.Value) error {
-	for i := 0; i < src.NumField(); i++ {
-		field := src.Field(i)
-		if dst.Kind() == reflect.Ptr {
-			dst = dst.Elem()
+			continue
 		}
-		structField := reflect.TypeOf(src.Interface()).Field(i)
-		dstValue := dst.FieldByName(structField.Name)
-		if dstValue == (reflect.Value{}) {
-			c.Logger.Printf("Field not found: %s", structField.Name)
-			return nil
+		err := c.copyField(st, field, dst, src)
+		st.pop()
+		if err != nil {
+			return err
 		}
-		err := c.copyInterface(dstValue, field)
+	}
+	for _, field := range p.required {
+		st.pushField(field.Name)
+		err := c.fail(st, st.wrap(ErrRequiredField, dst.Type(), src.Type()))
+		st.pop()
 		if err != nil {
 			return err
 		}
@@ -164,51 +351,106 @@ func (c *Copier) copyStruct(dst, src reflect.Value) error {
 	return nil
 }
 
-func (c *Copier) copySliceArray(dst, src reflect.Value) error {
-	if src.Len() == 0 {
+// copyField copies src struct field to matched dst struct field,
+// fields behind nil embedded pointers of src are skipped, nil embedded pointers of dst are allocated
+func (c *Copier) copyField(st *state, field fieldPlan, dst, src reflect.Value) error {
+	srcValue, ok := fieldByIndex(src, field.src.Index)
+	if !ok || c.skipMerge(srcValue, field.src.OmitEmpty || field.dst.OmitEmpty) {
 		return nil
 	}
-	dstElem := dst
-	if dst.Kind() == reflect.Ptr {
-		dstElem = dst.Elem()
+	dstValue, err := allocFieldByIndex(dst, field.dst.Index)
+	if err != nil {
+		return c.fail(st, st.wrap(err, dst.Type(), src.Type()))
 	}
-	var slice reflect.Value
-	switch {
-	case dstElem.Kind() == reflect.Slice && src.Len() > dstElem.Len():
-		slice = reflect.MakeSlice(dstElem.Type(), src.Len(), src.Len())
-	case dstElem.Kind() != reflect.Slice && dstElem.Kind() != reflect.Array:
-		return fmt.Errorf("%s: expected %s, actual %s", ErrDifferentTypes, src.Kind(), dstElem.Kind())
-	default:
-		slice = dstElem
+	if field.unexported {
+		srcValue, dstValue = exposed(srcValue), exposed(dstValue)
 	}
-	for i := 0; i < src.Len(); i++ {
-		err := c.copyInterface(slice.Index(i), src.Index(i))
-		if err != nil {
-			return err
+	return c.copyInterface(st, dstValue, srcValue)
+}
+
+// exposed returns addressable value of unexported field, which can be read and set
+func exposed(v reflect.Value) reflect.Value {
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// fieldByIndex returns nested field by index or false if it's behind nil embedded pointer
+func fieldByIndex(v reflect.Value, index []int) (reflect.Value, bool) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
 		}
+		v = v.Field(x)
 	}
-	if dstElem.Kind() == reflect.Slice {
-		dstElem.Set(slice)
+	return v, true
+}
+
+// allocFieldByIndex returns nested field by index, nil embedded pointers are allocated
+func allocFieldByIndex(v reflect.Value, index []int) (reflect.Value, error) {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("%w: nil embedded pointer %s", ErrCannotSetValue, v.Type())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
 	}
-	return nil
+	return v, nil
 }
 
-func (c *Copier) copyElement(dst, src reflect.Value) error {
-	if dst.Kind() == reflect.Ptr {
-		if dst.IsNil() {
-			newElem := reflect.New(reflect.TypeOf(dst.Interface()).Elem())
-			dst.Set(newElem)
+// unmatchedField handles src field without matching dst field according to UnmatchedFields policy
+func (c *Copier) unmatchedField(st *state, field fieldInfo) error {
+	if field.Required {
+		return st.wrap(ErrRequiredField, nil, field.Type)
+	}
+	path := st.currentPath()
+	switch c.UnmatchedFields {
+	case UnmatchedFieldIgnore:
+	case UnmatchedFieldReport:
+		st.unmatched = append(st.unmatched, path)
+	case UnmatchedFieldFail:
+		return &FieldNotFoundError{Paths: []string{path}}
+	default:
+		if c.Logger != nil {
+			c.Logger.Printf("Field not found: %s", path)
 		}
-		return c.copyInterface(dst.Elem(), src)
+	}
+	return nil
+}
+
+func (c *Copier) copySliceArray(st *state, dst, src reflect.Value) error {
+	switch dst.Kind() {
+	case reflect.Slice:
+		return c.copyToSlice(st, dst, src)
+	case reflect.Array:
+		return c.copyToArray(st, dst, src)
+	default:
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, src.Kind(), dst.Kind())
+	}
+}
+
+func (c *Copier) copyElement(st *state, dst, src reflect.Value) error {
+	if c.ConvertNumbers && src.Kind() != dst.Kind() && isNumberConversion(dst.Type(), src.Type()) {
+		return c.convertNumber(dst, src)
 	}
 	if src.Kind() != dst.Kind() {
-		return fmt.Errorf("%s: expected %s, actual %s", ErrDifferentTypes, src.Kind(), dst.Kind())
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, src.Kind(), dst.Kind())
+	}
+	if src.Type() != dst.Type() && c.canConvertNamed(dst.Type(), src.Type()) {
+		dst.Set(src.Convert(dst.Type()))
+		return nil
 	}
 	if src.Type() != dst.Type() {
-		return fmt.Errorf("%s: expected %s, actual %s", ErrDifferentTypes, src.Type(), dst.Type())
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, src.Type(), dst.Type())
 	}
 	if !dst.CanSet() {
-		return fmt.Errorf("%s: %v to %v", ErrCannotSetValue, src, dst)
+		return fmt.Errorf("%w: %v to %v", ErrCannotSetValue, src, dst)
 	}
 	dst.Set(src)
 	return nil
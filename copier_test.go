@@ -2,6 +2,10 @@ package copier
 
 import (
 	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -74,14 +78,14 @@ func Test_Copy_EmptySliceToSliceString(t *testing.T) {
 	var src = []string{}
 	err := Copy(&dst, &src, StringToIntConverter)
 	assert.NoError(t, err)
-	assert.Equal(t, []string(nil), dst)
+	assert.Equal(t, []string{}, dst)
 }
 
 func Test_Copy_SliceStringToSliceInt(t *testing.T) {
 	var dst []int
 	var src = []string{"Lorem", "ipsum", "dolor", "sit", "amet"}
 	err := Copy(&dst, &src)
-	assert.EqualError(t, err, "src and dst fields has different types: expected string, actual int")
+	assert.EqualError(t, err, "[0]: src and dst fields has different types: expected string, actual int")
 	assert.Equal(t, []int(nil), dst)
 }
 
@@ -105,7 +109,7 @@ func Test_Copy_SliceStringToSliceIntWithConverterInvalidNumber(t *testing.T) {
 	var dst []int
 	var src = []string{"Lorem", "ipsum", "dolor", "sit", "amet"}
 	err := Copy(&dst, &src, StringToIntConverter)
-	assert.EqualError(t, err, `strconv.Atoi: parsing "Lorem": invalid syntax`)
+	assert.EqualError(t, err, `[0]: strconv.Atoi: parsing "Lorem": invalid syntax`)
 	assert.Equal(t, []int(nil), dst)
 }
 
@@ -121,7 +125,8 @@ func Test_Copy_MapStringStringToMapStringInt(t *testing.T) {
 	var dst map[string]int
 	var src = map[string]string{"Lorem": "100", "ipsum": "200", "dolor": "300", "sit": "400", "amet": "500"}
 	err := Copy(&dst, &src)
-	assert.EqualError(t, err, "src and dst fields has different types: expected string, actual int")
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+	assert.Regexp(t, `^\["\w+"\]: src and dst fields has different types: expected string, actual int$`, err.Error())
 	expRes := map[string]int{}
 	assert.Equal(t, expRes, dst)
 }
@@ -148,7 +153,8 @@ func Test_Copy_MapStringStingToMapIntInt(t *testing.T) {
 	var dst map[int]int
 	var src = map[string]string{"1": "100", "4": "200", "20": "300", "30": "400", "100": "500"}
 	err := Copy(&dst, &src)
-	assert.EqualError(t, err, "src and dst fields has different types: expected string, actual int")
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+	assert.Regexp(t, `^\["\w+"\]: src and dst fields has different types: expected string, actual int$`, err.Error())
 	expRes := map[int]int{}
 	assert.Equal(t, expRes, dst)
 }
@@ -205,7 +211,7 @@ func Test_Copy_StructAToStructB(t *testing.T) {
 	}
 	expResult := B{}
 	err := Copy(&dst, &src)
-	assert.EqualError(t, err, "src and dst fields has different types: expected string, actual int")
+	assert.EqualError(t, err, "ID: src and dst fields has different types: expected string, actual int")
 	assert.Equal(t, expResult, dst)
 }
 
@@ -453,3 +459,745 @@ func Test_Copy_SliceOfStructToMapOfStructWithCustomConverter(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expResult, dst)
 }
+
+func Test_Copy_StructWithSrcTagToStruct(t *testing.T) {
+	type A struct {
+		FullName string `copier:"Name"`
+		Age      int
+	}
+	type B struct {
+		Name string
+		Age  int
+	}
+	var dst B
+	var src = A{FullName: "Jonh", Age: 30}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Name: "Jonh", Age: 30}, dst)
+}
+
+func Test_Copy_StructToStructWithDstTag(t *testing.T) {
+	type A struct {
+		FullName string
+		Age      int
+	}
+	type B struct {
+		Name string `copier:"FullName"`
+		Age  int
+	}
+	var dst B
+	var src = A{FullName: "Jonh", Age: 30}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Name: "Jonh", Age: 30}, dst)
+}
+
+func Test_Copy_StructWithSkipTag(t *testing.T) {
+	type A struct {
+		Name     string
+		Password string `copier:"-"`
+	}
+	type B struct {
+		Name     string
+		Password string
+		Token    string `copier:"-"`
+	}
+	var dst = B{Token: "token"}
+	var src = A{Name: "Jonh", Password: "secret"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Name: "Jonh", Token: "token"}, dst)
+}
+
+func Test_Copy_StructWithRequiredSrcFieldNotFound(t *testing.T) {
+	type A struct {
+		Name  string
+		Email string `copier:",required"`
+	}
+	type B struct {
+		Name string
+	}
+	var dst B
+	var src = A{Name: "Jonh", Email: "jonh@example.com"}
+	err := Copy(&dst, &src)
+	assert.True(t, errors.Is(err, ErrRequiredField))
+	assert.EqualError(t, err, "Email: required field not found")
+}
+
+func Test_Copy_StructWithRequiredDstFieldNotFound(t *testing.T) {
+	type A struct {
+		Name string
+	}
+	type B struct {
+		Name  string
+		Email string `copier:"Mail,required"`
+	}
+	var dst B
+	var src = A{Name: "Jonh"}
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "Mail: required field not found")
+	assert.Equal(t, B{Name: "Jonh"}, dst)
+}
+
+func Test_Copy_StructWithUnmatchedFieldContinues(t *testing.T) {
+	type A struct {
+		ID   int
+		Type string
+		Name string
+	}
+	type B struct {
+		ID   int
+		Name string
+	}
+	var dst B
+	var src = A{ID: 100, Type: "skipped", Name: "Jonh"}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldIgnore
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{ID: 100, Name: "Jonh"}, dst)
+}
+
+func Test_Copy_StructWithUnmatchedFieldReport(t *testing.T) {
+	type Item struct {
+		Name  string
+		Color string
+	}
+	type A struct {
+		Type  string
+		Items []Item
+		Name  string
+	}
+	type ItemB struct {
+		Name string
+	}
+	type B struct {
+		Items []ItemB
+		Name  string
+	}
+	var dst B
+	var src = A{Type: "skipped", Items: []Item{{Name: "Lorem", Color: "red"}}, Name: "Jonh"}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldReport
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "field not found: Type, Items[0].Color")
+	assert.True(t, errors.Is(err, ErrFieldNotFound))
+	var notFound *FieldNotFoundError
+	assert.True(t, errors.As(err, &notFound))
+	assert.Equal(t, []string{"Type", "Items[0].Color"}, notFound.Paths)
+	assert.Equal(t, B{Items: []ItemB{{Name: "Lorem"}}, Name: "Jonh"}, dst)
+}
+
+func Test_Copy_StructWithUnmatchedFieldFail(t *testing.T) {
+	type A struct {
+		ID   int
+		Type string
+		Name string
+	}
+	type B struct {
+		ID   int
+		Name string
+	}
+	var dst B
+	var src = A{ID: 100, Type: "skipped", Name: "Jonh"}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldFail
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "field not found: Type")
+	assert.True(t, errors.Is(err, ErrFieldNotFound))
+	assert.Equal(t, B{ID: 100}, dst)
+}
+
+func Test_Copy_ConcurrentWithCachedPlans(t *testing.T) {
+	type A struct {
+		ID     string
+		Values []string
+	}
+	type B struct {
+		ID     int
+		Values []int
+	}
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var dst B
+			var src = A{ID: "100", Values: []string{"200", "300"}}
+			err := copier.Copy(&dst, &src)
+			assert.NoError(t, err)
+			assert.Equal(t, B{ID: 100, Values: []int{200, 300}}, dst)
+		}()
+	}
+	wg.Wait()
+}
+
+func Test_Copier_SetConvertersResetsPlans(t *testing.T) {
+	var dst int
+	var src = "100"
+	copier := New()
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "src and dst fields has different types: expected string, actual int")
+	copier.SetConverters([]Converter{StringToIntConverter})
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, dst)
+}
+
+func Test_Copy_NestedErrorPath(t *testing.T) {
+	type ItemA struct {
+		Prices map[string]string
+	}
+	type OrderA struct {
+		Items []ItemA
+	}
+	type ItemB struct {
+		Prices map[string]int
+	}
+	type OrderB struct {
+		Items []ItemB
+	}
+	type A struct {
+		Orders []OrderA
+	}
+	type B struct {
+		Orders []OrderB
+	}
+	var dst B
+	var src = A{Orders: []OrderA{
+		{Items: []ItemA{{Prices: map[string]string{"sku": "100"}}}},
+		{Items: []ItemA{{Prices: map[string]string{"sku": "Lorem"}}}},
+	}}
+	err := Copy(&dst, &src, StringToIntConverter)
+	assert.EqualError(t, err, `Orders[1].Items[0].Prices["sku"]: strconv.Atoi: parsing "Lorem": invalid syntax`)
+	var copyErr *CopyError
+	assert.True(t, errors.As(err, &copyErr))
+	assert.Equal(t, `Orders[1].Items[0].Prices["sku"]`, copyErr.Path)
+	assert.Equal(t, reflect.TypeOf(""), copyErr.SrcType)
+	assert.Equal(t, reflect.TypeOf(0), copyErr.DstType)
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+}
+
+func Test_Copy_DifferentTypesErrorIs(t *testing.T) {
+	type A struct {
+		Values []string
+	}
+	type B struct {
+		Values []int
+	}
+	var dst B
+	var src = A{Values: []string{"100"}}
+	err := Copy(&dst, &src)
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+	var copyErr *CopyError
+	assert.True(t, errors.As(err, &copyErr))
+	assert.Equal(t, "Values[0]", copyErr.Path)
+}
+
+func Test_Copy_AggregateErrors(t *testing.T) {
+	type A struct {
+		ID     string
+		Name   string
+		Age    string
+		Values []string
+		Prices map[string]string
+	}
+	type B struct {
+		ID     int
+		Name   string
+		Age    int
+		Values []int
+		Prices map[string]int
+	}
+	var dst B
+	var src = A{
+		ID:     "Lorem",
+		Name:   "Jonh",
+		Age:    "30",
+		Values: []string{"100", "ipsum", "300"},
+		Prices: map[string]string{"sku": "dolor", "ean": "500"},
+	}
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	copier.AggregateErrors = true
+	err := copier.Copy(&dst, &src)
+	var multiErr *MultiError
+	assert.True(t, errors.As(err, &multiErr))
+	assert.Equal(t, []string{"ID", "Values[1]", `Prices["sku"]`}, multiErr.Paths())
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	assert.Equal(t, B{
+		Name:   "Jonh",
+		Age:    30,
+		Values: []int{100, 0, 300},
+		Prices: map[string]int{"ean": 500},
+	}, dst)
+}
+
+func Test_Copy_AggregateErrorsWithUnmatchedFields(t *testing.T) {
+	type A struct {
+		ID   string
+		Type string
+	}
+	type B struct {
+		ID int
+	}
+	var dst B
+	var src = A{ID: "Lorem", Type: "skipped"}
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	copier.AggregateErrors = true
+	copier.UnmatchedFields = UnmatchedFieldReport
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "ID: strconv.Atoi: parsing \"Lorem\": invalid syntax\nfield not found: Type")
+	assert.True(t, errors.Is(err, ErrFieldNotFound))
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+}
+
+func Test_Copy_AggregateErrorsContinuesAfterUnmatchedField(t *testing.T) {
+	type A struct {
+		A string
+		X string
+		B string
+	}
+	type B struct {
+		A string
+		B string
+	}
+	var dst B
+	var src = A{A: "a", X: "x", B: "b"}
+	copier := New()
+	copier.AggregateErrors = true
+	copier.UnmatchedFields = UnmatchedFieldFail
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "field not found: X")
+	assert.True(t, errors.Is(err, ErrFieldNotFound))
+	assert.Equal(t, B{A: "a", B: "b"}, dst)
+}
+
+func Test_Copy_AggregateErrorsWithRequiredFields(t *testing.T) {
+	type A struct {
+		Name string
+	}
+	type B struct {
+		ID    string `copier:",required"`
+		Email string `copier:",required"`
+		Name  string
+	}
+	var dst B
+	var src = A{Name: "Jonh"}
+	copier := New()
+	copier.AggregateErrors = true
+	err := copier.Copy(&dst, &src)
+	var multiErr *MultiError
+	assert.True(t, errors.As(err, &multiErr))
+	assert.Equal(t, []string{"ID", "Email"}, multiErr.Paths())
+	assert.True(t, errors.Is(err, ErrRequiredField))
+	assert.Equal(t, B{Name: "Jonh"}, dst)
+}
+
+func Test_Copy_AggregateErrorsWithoutErrors(t *testing.T) {
+	var dst []int
+	var src = []string{"100", "200"}
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	copier.AggregateErrors = true
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{100, 200}, dst)
+}
+
+type testBaseModel struct {
+	ID        int
+	CreatedAt string
+}
+
+type testAuditModel struct {
+	CreatedBy string
+}
+
+func Test_Copy_EmbeddedStructToFlatStruct(t *testing.T) {
+	type A struct {
+		testBaseModel
+		Name string
+	}
+	type B struct {
+		ID        int
+		CreatedAt string
+		Name      string
+	}
+	var dst B
+	var src = A{testBaseModel: testBaseModel{ID: 100, CreatedAt: "today"}, Name: "Jonh"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{ID: 100, CreatedAt: "today", Name: "Jonh"}, dst)
+}
+
+func Test_Copy_FlatStructToEmbeddedPointer(t *testing.T) {
+	type Base struct {
+		ID        int
+		CreatedAt string
+	}
+	type A struct {
+		ID        int
+		CreatedAt string
+		Name      string
+	}
+	type B struct {
+		*Base
+		Name string
+	}
+	var dst B
+	var src = A{ID: 100, CreatedAt: "today", Name: "Jonh"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Base: &Base{ID: 100, CreatedAt: "today"}, Name: "Jonh"}, dst)
+}
+
+func Test_Copy_FlatStructToUnexportedEmbeddedPointer(t *testing.T) {
+	type A struct {
+		ID   int
+		Name string
+	}
+	type B struct {
+		*testBaseModel
+		Name string
+	}
+	var dst B
+	var src = A{ID: 100, Name: "Jonh"}
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "ID: can not set value: nil embedded pointer *copier.testBaseModel")
+	assert.True(t, errors.Is(err, ErrCannotSetValue))
+}
+
+func Test_Copy_NilEmbeddedPointerToFlatStruct(t *testing.T) {
+	type A struct {
+		*testBaseModel
+		Name string
+	}
+	type B struct {
+		ID   int
+		Name string
+	}
+	var dst = B{ID: 100}
+	var src = A{Name: "Jonh"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{ID: 100, Name: "Jonh"}, dst)
+}
+
+func Test_Copy_EmbeddedStructToEmbeddedStruct(t *testing.T) {
+	type A struct {
+		*testBaseModel
+		testAuditModel
+		Name string
+	}
+	type B struct {
+		testBaseModel
+		Audit testAuditModel `copier:"-"`
+		testAuditModel
+		Name string
+	}
+	var dst B
+	var src = A{
+		testBaseModel:  &testBaseModel{ID: 100},
+		testAuditModel: testAuditModel{CreatedBy: "admin"},
+		Name:           "Jonh",
+	}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{
+		testBaseModel:  testBaseModel{ID: 100},
+		testAuditModel: testAuditModel{CreatedBy: "admin"},
+		Name:           "Jonh",
+	}, dst)
+}
+
+func Test_Copy_EmbeddedStructFieldShadowing(t *testing.T) {
+	type Named struct {
+		Name string
+	}
+	type Titled struct {
+		Name string
+	}
+	type A struct {
+		testBaseModel
+		Named
+		Titled
+		ID string
+	}
+	type B struct {
+		ID   string
+		Name string
+	}
+	var dst B
+	var src = A{
+		testBaseModel: testBaseModel{ID: 100},
+		Named:         Named{Name: "Jonh"},
+		Titled:        Titled{Name: "Mr"},
+		ID:            "outer",
+	}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldReport
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "field not found: CreatedAt")
+	assert.Equal(t, B{ID: "outer"}, dst)
+}
+
+func Test_Copy_EmbeddedStructWithTagName(t *testing.T) {
+	type Model struct {
+		ID int
+	}
+	type A struct {
+		Model `copier:"Base"`
+	}
+	type B struct {
+		Base Model
+	}
+	var dst B
+	var src = A{Model: Model{ID: 100}}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Base: Model{ID: 100}}, dst)
+}
+
+func Test_Copy_EmbeddedStructToFieldOfTypeName(t *testing.T) {
+	type PBase struct {
+		ID   int
+		Kind string
+	}
+	type A struct {
+		PBase
+	}
+	type B struct {
+		PBase PBase
+	}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldReport
+	var dst B
+	var src = A{PBase: PBase{ID: 100, Kind: "user"}}
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{PBase: PBase{ID: 100, Kind: "user"}}, dst)
+}
+
+func Test_Copy_FieldOfTypeNameToEmbeddedStruct(t *testing.T) {
+	type PBase struct {
+		ID   int
+		Kind string
+	}
+	type A struct {
+		PBase PBase
+	}
+	type B struct {
+		*PBase
+	}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldReport
+	var dst B
+	var src = A{PBase: PBase{ID: 100, Kind: "user"}}
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{PBase: &PBase{ID: 100, Kind: "user"}}, dst)
+}
+
+type testUnexported struct {
+	Name   string
+	secret string
+	tags   []string
+}
+
+func Test_Copy_UnexportedFieldsBetweenDifferentTypes(t *testing.T) {
+	type A struct {
+		Name   string
+		secret string
+		hidden int
+	}
+	type B struct {
+		Name   string
+		secret string
+	}
+	var dst B
+	var src = A{Name: "Jonh", secret: "secret", hidden: 100}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldFail
+	copier.CopyUnexported = true
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Name: "Jonh"}, dst)
+}
+
+func Test_Copy_UnexportedFieldsSkippedByDefault(t *testing.T) {
+	var dst testUnexported
+	var src = testUnexported{Name: "Jonh", secret: "secret", tags: []string{"Lorem"}}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, testUnexported{Name: "Jonh"}, dst)
+}
+
+func Test_Copy_UnexportedFieldsOfTheSameType(t *testing.T) {
+	var dst testUnexported
+	var src = testUnexported{Name: "Jonh", secret: "secret", tags: []string{"Lorem"}}
+	copier := New()
+	copier.CopyUnexported = true
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+	src.tags[0] = "ipsum"
+	assert.Equal(t, []string{"Lorem"}, dst.tags)
+}
+
+func Test_Copy_UnexportedFieldsOfNotAddressableValue(t *testing.T) {
+	var dst testUnexported
+	var src interface{} = testUnexported{Name: "Jonh", secret: "secret", tags: []string{"Lorem"}}
+	copier := New()
+	copier.CopyUnexported = true
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+}
+
+type testNodeA struct {
+	Value    string
+	Next     *testNodeA
+	Parent   *testNodeA
+	Children []*testNodeA
+}
+
+type testNodeB struct {
+	Value    string
+	Next     *testNodeB
+	Parent   *testNodeB
+	Children []*testNodeB
+}
+
+func Test_Copy_PointerCycle(t *testing.T) {
+	first := &testNodeA{Value: "first"}
+	second := &testNodeA{Value: "second", Next: first}
+	first.Next = second
+	var dst *testNodeB
+	err := Copy(&dst, &first)
+	assert.NoError(t, err)
+	assert.Equal(t, "first", dst.Value)
+	assert.Equal(t, "second", dst.Next.Value)
+	assert.Same(t, dst, dst.Next.Next)
+}
+
+func Test_Copy_ParentChildBackPointers(t *testing.T) {
+	root := &testNodeA{Value: "root"}
+	root.Children = []*testNodeA{{Value: "Lorem", Parent: root}, {Value: "ipsum", Parent: root}}
+	dst, err := Clone(root)
+	assert.NoError(t, err)
+	assert.NotSame(t, root, dst)
+	assert.Len(t, dst.Children, 2)
+	assert.Same(t, dst, dst.Children[0].Parent)
+	assert.Same(t, dst, dst.Children[1].Parent)
+}
+
+func Test_Copy_SharedPointersStayShared(t *testing.T) {
+	shared := &testNodeA{Value: "shared"}
+	type A struct {
+		First  *testNodeA
+		Second *testNodeA
+	}
+	type B struct {
+		First  *testNodeB
+		Second *testNodeB
+	}
+	var dst B
+	var src = A{First: shared, Second: shared}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, "shared", dst.First.Value)
+	assert.Same(t, dst.First, dst.Second)
+}
+
+func Test_Copy_MaxDepth(t *testing.T) {
+	cyclic := map[string]interface{}{"Lorem": "ipsum"}
+	cyclic["self"] = cyclic
+	var dst map[string]interface{}
+	copier := New()
+	copier.MaxDepth = 10
+	err := copier.Copy(&dst, &cyclic)
+	assert.True(t, errors.Is(err, ErrMaxDepth))
+	var copyErr *CopyError
+	assert.True(t, errors.As(err, &copyErr))
+	assert.Regexp(t, `^(\["self"\])+`, copyErr.Path)
+}
+
+func Test_Copy_MaxDepthNotExceeded(t *testing.T) {
+	type A struct {
+		Values [][]string
+	}
+	var dst A
+	var src = A{Values: [][]string{{"Lorem"}}}
+	copier := New()
+	copier.MaxDepth = 4
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+	copier.MaxDepth = 3
+	err = copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "Values[0][0]: max depth exceeded: 3")
+}
+
+func Test_Copy_ConvertersAssignedAfterCopy(t *testing.T) {
+	var dst int
+	var src = "100"
+	copier := New()
+	err := copier.Copy(&dst, &src)
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+
+	copier.Converters = []Converter{StringToIntConverter}
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, dst)
+
+	copier.Converters[0] = NewConverter(func(src string) (int, error) {
+		return len(src), nil
+	})
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, dst)
+}
+
+func Test_Copy_FieldNameResolversAssignedAfterCopy(t *testing.T) {
+	type A struct {
+		Title string `json:"name"`
+	}
+	type B struct {
+		Name string
+	}
+	var dst B
+	var src = A{Title: "Lorem"}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldIgnore
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{}, dst)
+
+	copier.SrcFieldNames = TagNameResolver("json")
+	copier.DstFieldNames = func(sf reflect.StructField) (string, bool) {
+		return strings.ToLower(sf.Name), true
+	}
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Name: "Lorem"}, dst)
+}
+
+func Test_Copy_ReusesCopierOfConverters(t *testing.T) {
+	var dst int
+	var src = "100"
+	err := Copy(&dst, &src, StringToIntConverter)
+	assert.NoError(t, err)
+	assert.Same(t, copierWith([]Converter{StringToIntConverter}), copierWith([]Converter{StringToIntConverter}))
+	assert.NotSame(t, copierWith([]Converter{StringToIntConverter}), copierWith([]Converter{StringToIntConverter, IntToStringConverter}))
+
+	other := NewConverter(func(src string) (int, error) {
+		return len(src), nil
+	})
+	err = Copy(&dst, &src, other)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, dst)
+}
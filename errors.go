@@ -0,0 +1,91 @@
+package copier
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// FieldNotFoundError represents error src fields have no matching dst fields.
+// Paths contains full path of every unmatched src field.
+type FieldNotFoundError struct {
+	Paths []string
+}
+
+func (e *FieldNotFoundError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrFieldNotFound, strings.Join(e.Paths, ", "))
+}
+
+// Unwrap returns ErrFieldNotFound
+func (e *FieldNotFoundError) Unwrap() error {
+	return ErrFieldNotFound
+}
+
+// CopyError represents error of copying value at Path, e.g. `Orders[3].Items["sku"].Price`.
+// Err is the cause of error, so errors.Is(err, ErrDifferentTypes) and similar checks work with CopyError.
+type CopyError struct {
+	Path    string
+	SrcType reflect.Type
+	DstType reflect.Type
+	Err     error
+}
+
+func (e *CopyError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+// Unwrap returns cause of error
+func (e *CopyError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError represents all errors collected by Copier with AggregateErrors enabled.
+// It's compatible with errors.Is and errors.As the same way as errors.Join result.
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "\n")
+}
+
+// Unwrap returns all collected errors
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
+
+// Paths returns paths of all failed values
+func (e *MultiError) Paths() []string {
+	paths := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		switch err := err.(type) {
+		case *CopyError:
+			paths = append(paths, err.Path)
+		case *FieldNotFoundError:
+			paths = append(paths, err.Paths...)
+		}
+	}
+	return paths
+}
+
+// LengthMismatchError represents error src length doesn't match dst array length
+type LengthMismatchError struct {
+	SrcLen int
+	DstLen int
+}
+
+func (e *LengthMismatchError) Error() string {
+	return fmt.Sprintf("%s: src length %d, dst length %d", ErrLengthMismatch, e.SrcLen, e.DstLen)
+}
+
+// Unwrap returns ErrLengthMismatch
+func (e *LengthMismatchError) Unwrap() error {
+	return ErrLengthMismatch
+}
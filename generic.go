@@ -0,0 +1,42 @@
+package copier
+
+// cloner is used by Clone, it copies unexported fields too
+var cloner = &Copier{
+	UnmatchedFields: UnmatchedFieldIgnore,
+	CopyUnexported:  true,
+}
+
+// Clone makes deep copy of v: structs including unexported fields, slices, maps, pointers and arrays
+// are copied to new values, so the result doesn't share memory with v
+func Clone[T any](v T) (T, error) {
+	return CopyToWith[T](cloner, v)
+}
+
+// CopyTo makes copy of src to new value of type D.
+// Converters are applied the same way as in Copy.
+func CopyTo[D, S any](src S, cc ...Converter) (D, error) {
+	return CopyToWith[D](copierWith(cc), src)
+}
+
+// CopyToWith makes copy of src to new value of type D using given copier
+func CopyToWith[D, S any](c *Copier, src S) (D, error) {
+	var dst D
+	err := c.Copy(&dst, &src)
+	return dst, err
+}
+
+// CopySlice makes copy of every element of src to new slice with elements of type D.
+// Nil src slice is copied to nil slice.
+func CopySlice[D, S any](src []S, cc ...Converter) ([]D, error) {
+	return CopySliceWith[D](copierWith(cc), src)
+}
+
+// CopySliceWith makes copy of every element of src to new slice with elements of type D using given copier
+func CopySliceWith[D, S any](c *Copier, src []S) ([]D, error) {
+	if src == nil {
+		return nil, nil
+	}
+	var dst []D
+	err := c.Copy(&dst, &src)
+	return dst, err
+}
@@ -0,0 +1,196 @@
+package copier
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CopyTo_Struct(t *testing.T) {
+	type A struct {
+		ID   string
+		Name string
+	}
+	type B struct {
+		ID   int
+		Name string
+	}
+	dst, err := CopyTo[B](A{ID: "100", Name: "Jonh"}, StringToIntConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, B{ID: 100, Name: "Jonh"}, dst)
+}
+
+func Test_CopyTo_PointerSource(t *testing.T) {
+	type A struct {
+		Name string
+	}
+	type B struct {
+		Name string
+	}
+	dst, err := CopyTo[*B](&A{Name: "Jonh"})
+	assert.NoError(t, err)
+	assert.Equal(t, &B{Name: "Jonh"}, dst)
+}
+
+func Test_CopyTo_Error(t *testing.T) {
+	dst, err := CopyTo[int]("100")
+	assert.EqualError(t, err, "src and dst fields has different types: expected string, actual int")
+	assert.Equal(t, 0, dst)
+}
+
+func Test_CopyToWith_Copier(t *testing.T) {
+	copier := New()
+	copier.SetConverters([]Converter{IntToStringConverter})
+	dst, err := CopyToWith[string](copier, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, "100", dst)
+}
+
+func Test_CopySlice_Structs(t *testing.T) {
+	type A struct {
+		ID   string
+		Name string
+	}
+	type B struct {
+		ID   int
+		Name string
+	}
+	src := []A{{ID: "100", Name: "Jonh"}, {ID: "200", Name: "Bill"}}
+	dst, err := CopySlice[B](src, StringToIntConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, []B{{ID: 100, Name: "Jonh"}, {ID: 200, Name: "Bill"}}, dst)
+}
+
+func Test_CopySlice_Nil(t *testing.T) {
+	dst, err := CopySlice[int]([]string(nil), StringToIntConverter)
+	assert.NoError(t, err)
+	assert.Nil(t, dst)
+}
+
+func Test_CopySlice_Empty(t *testing.T) {
+	dst, err := CopySlice[int]([]string{}, StringToIntConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{}, dst)
+}
+
+func Test_CopySliceWith_SliceModes(t *testing.T) {
+	for _, mode := range []SliceMode{SliceReplace, SliceAppend, SliceMergeByIndex} {
+		copier := New()
+		copier.SetConverters([]Converter{StringToIntConverter})
+		copier.Slices = mode
+		dst, err := CopySliceWith[int](copier, []string{"1", "2"})
+		assert.NoError(t, err)
+		assert.Equal(t, []int{1, 2}, dst, "mode %d", mode)
+	}
+}
+
+func Test_CopySlice_Error(t *testing.T) {
+	_, err := CopySlice[int]([]string{"100", "Lorem"}, StringToIntConverter)
+	assert.EqualError(t, err, `[1]: strconv.Atoi: parsing "Lorem": invalid syntax`)
+}
+
+type testCloneItem struct {
+	Name  string
+	Tags  []string
+	price *int
+}
+
+type testCloneOrder struct {
+	ID       int
+	Items    []testCloneItem
+	Index    map[string]*testCloneItem
+	Groups   map[string][]string
+	Matrix   [2][]int
+	Customer *string
+	Extra    interface{}
+	notes    map[string]string
+}
+
+func Test_Clone_NoAliasing(t *testing.T) {
+	price := 100
+	customer := "Jonh"
+	src := testCloneOrder{
+		ID:       1,
+		Items:    []testCloneItem{{Name: "Lorem", Tags: []string{"a", "b"}, price: &price}},
+		Index:    map[string]*testCloneItem{"Lorem": {Name: "Lorem", Tags: []string{"c"}}},
+		Groups:   map[string][]string{"Lorem": {"ipsum", "dolor"}},
+		Matrix:   [2][]int{{1, 2}, {3}},
+		Customer: &customer,
+		Extra:    map[string]interface{}{"list": []int{1, 2}},
+		notes:    map[string]string{"Lorem": "ipsum"},
+	}
+	dst, err := Clone(src)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+
+	src.Items[0].Tags[0] = "changed"
+	*src.Items[0].price = 200
+	src.Index["Lorem"].Tags[0] = "changed"
+	src.Groups["Lorem"][0] = "changed"
+	src.Matrix[0][0] = 100
+	*src.Customer = "Bill"
+	src.Extra.(map[string]interface{})["list"].([]int)[0] = 100
+	src.notes["Lorem"] = "changed"
+
+	assert.Equal(t, "a", dst.Items[0].Tags[0])
+	assert.Equal(t, 100, *dst.Items[0].price)
+	assert.Equal(t, "c", dst.Index["Lorem"].Tags[0])
+	assert.Equal(t, "ipsum", dst.Groups["Lorem"][0])
+	assert.Equal(t, 1, dst.Matrix[0][0])
+	assert.Equal(t, "Jonh", *dst.Customer)
+	assert.Equal(t, 1, dst.Extra.(map[string]interface{})["list"].([]int)[0])
+	assert.Equal(t, "ipsum", dst.notes["Lorem"])
+}
+
+func Test_Clone_Pointer(t *testing.T) {
+	src := &testCloneItem{Name: "Lorem", Tags: []string{"a"}}
+	dst, err := Clone(src)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+	assert.NotSame(t, src, dst)
+}
+
+func Test_Clone_NilPointer(t *testing.T) {
+	var src *testCloneItem
+	dst, err := Clone(src)
+	assert.NoError(t, err)
+	assert.Nil(t, dst)
+}
+
+func Test_Clone_SliceAndMap(t *testing.T) {
+	srcSlice := [][]int{{1, 2}, {3}}
+	dstSlice, err := Clone(srcSlice)
+	assert.NoError(t, err)
+	srcSlice[0][0] = 100
+	assert.Equal(t, [][]int{{1, 2}, {3}}, dstSlice)
+
+	srcMap := map[int][]string{1: {"Lorem"}}
+	dstMap, err := Clone(srcMap)
+	assert.NoError(t, err)
+	srcMap[1][0] = "ipsum"
+	assert.Equal(t, map[int][]string{1: {"Lorem"}}, dstMap)
+}
+
+func Test_Clone_NilMapsAndSlices(t *testing.T) {
+	type A struct {
+		Map   map[string]int
+		Slice []int
+		Empty map[string]int
+	}
+	src := A{Empty: map[string]int{}}
+	dst, err := Clone(src)
+	assert.NoError(t, err)
+	assert.True(t, reflect.DeepEqual(src, dst))
+	assert.Nil(t, dst.Map)
+	assert.Nil(t, dst.Slice)
+	assert.NotNil(t, dst.Empty)
+
+	dstMap, err := Clone(map[string]int(nil))
+	assert.NoError(t, err)
+	assert.Nil(t, dstMap)
+
+	dstSlice, err := Clone([]int(nil))
+	assert.NoError(t, err)
+	assert.Nil(t, dstSlice)
+}
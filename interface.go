@@ -0,0 +1,48 @@
+package copier
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Implementation represents concrete type, which is used by Copier
+// to populate dst value of interface type
+type Implementation struct {
+	// Interface is nil pointer to interface, e.g. (*io.Reader)(nil)
+	Interface interface{}
+	// Concrete is value of concrete type, e.g. &bytes.Buffer{}
+	Concrete interface{}
+}
+
+// implementation returns registered concrete type for interface type or nil
+func (c *Copier) implementation(iface reflect.Type) reflect.Type {
+	for _, impl := range c.Implementations {
+		if reflect.TypeOf(impl.Interface).Elem() == iface {
+			return reflect.TypeOf(impl.Concrete)
+		}
+	}
+	return nil
+}
+
+// copyToInterface makes copy of src to new value of registered implementation
+// or src type and sets it to dst of interface type
+func (c *Copier) copyToInterface(st *state, dst, src reflect.Value) error {
+	target := c.implementation(dst.Type())
+	if target == nil {
+		target = src.Type()
+	}
+	if !target.AssignableTo(dst.Type()) {
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, target, dst.Type())
+	}
+	value := reflect.New(target).Elem()
+	if !dst.IsNil() && dst.Elem().Type() == target {
+		// existing value of the same type is copied onto, like non-interface dst values
+		value.Set(dst.Elem())
+	}
+	err := c.copyInterface(st, value, src)
+	if err != nil {
+		return err
+	}
+	dst.Set(value)
+	return nil
+}
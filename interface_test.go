@@ -0,0 +1,137 @@
+package copier
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testShape interface {
+	Area() float64
+}
+
+type testSquare struct {
+	Side float64
+}
+
+func (s testSquare) Area() float64 {
+	return s.Side * s.Side
+}
+
+type testRect struct {
+	Width  float64
+	Height float64
+}
+
+func (r *testRect) Area() float64 {
+	return r.Width * r.Height
+}
+
+func Test_Copy_InterfaceWithStructToStruct(t *testing.T) {
+	type Item struct {
+		Name string
+	}
+	type A struct {
+		Value interface{}
+	}
+	type B struct {
+		Value Item
+	}
+	var dst B
+	var src = A{Value: Item{Name: "Lorem"}}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Value: Item{Name: "Lorem"}}, dst)
+}
+
+func Test_Copy_InterfaceWithConverter(t *testing.T) {
+	type A struct {
+		Values []interface{}
+	}
+	type B struct {
+		Values []string
+	}
+	var dst B
+	var src = A{Values: []interface{}{100, 200}}
+	err := Copy(&dst, &src, IntToStringConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Values: []string{"100", "200"}}, dst)
+}
+
+func Test_Copy_InterfaceToInterfaceDeepCopy(t *testing.T) {
+	type A struct {
+		Value any
+	}
+	values := []string{"Lorem", "ipsum"}
+	items := map[string]int{"Lorem": 100}
+	var dst []A
+	var src = []A{{Value: values}, {Value: items}, {Value: &testRect{Width: 2, Height: 3}}, {Value: nil}}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+	values[0] = "dolor"
+	items["Lorem"] = 200
+	assert.Equal(t, []string{"Lorem", "ipsum"}, dst[0].Value)
+	assert.Equal(t, map[string]int{"Lorem": 100}, dst[1].Value)
+	assert.NotSame(t, src[2].Value, dst[2].Value)
+}
+
+func Test_Copy_StructToInterface(t *testing.T) {
+	type A struct {
+		Shape testSquare
+	}
+	type B struct {
+		Shape testShape
+	}
+	var dst B
+	var src = A{Shape: testSquare{Side: 2}}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Shape: testSquare{Side: 2}}, dst)
+	assert.Equal(t, 4.0, dst.Shape.Area())
+}
+
+func Test_Copy_StructToInterfaceWithImplementation(t *testing.T) {
+	type Size struct {
+		Width  float64
+		Height float64
+	}
+	type A struct {
+		Shape Size
+	}
+	type B struct {
+		Shape testShape
+	}
+	var dst B
+	var src = A{Shape: Size{Width: 2, Height: 3}}
+	copier := New()
+	copier.Implementations = []Implementation{{Interface: (*testShape)(nil), Concrete: &testRect{}}}
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Shape: &testRect{Width: 2, Height: 3}}, dst)
+	assert.Equal(t, 6.0, dst.Shape.Area())
+}
+
+func Test_Copy_StructToInterfaceNotImplemented(t *testing.T) {
+	type A struct {
+		Shape testRect
+	}
+	type B struct {
+		Shape testShape
+	}
+	var dst B
+	var src = A{Shape: testRect{Width: 2, Height: 3}}
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "Shape: src and dst fields has different types: expected copier.testRect, actual copier.testShape")
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+}
+
+func Test_Copy_InterfaceToStringer(t *testing.T) {
+	var dst fmt.Stringer
+	var src interface{} = errors.New("Lorem")
+	err := Copy(&dst, &src)
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+	assert.Nil(t, dst)
+}
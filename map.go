@@ -0,0 +1,82 @@
+package copier
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// MapMode represents the way src map is copied to existing dst map
+type MapMode int
+
+const (
+	// MapReplace replaces dst with new map containing only src entries, nil src map is copied to nil map
+	MapReplace MapMode = iota
+	// MapMergeOverwrite adds src entries to dst map, values of existing keys are replaced
+	MapMergeOverwrite
+	// MapMergeKeepExisting adds only src entries which keys are missing in dst map
+	MapMergeKeepExisting
+	// MapDeepMerge adds src entries to dst map, src values are copied onto dst values of existing keys,
+	// so struct and map values are merged instead of replaced
+	MapDeepMerge
+)
+
+func (c *Copier) copyMap(st *state, dst, src reflect.Value) error {
+	if src.Kind() != dst.Kind() {
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, src.Kind(), dst.Kind())
+	}
+	if src.IsNil() && c.Maps == MapReplace {
+		// nil map is preserved like nil slice with EmptySlicePreserve
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+	c.prepareMap(dst, src.Len())
+	for _, key := range src.MapKeys() {
+		st.pushKey(key)
+		err := c.copyMapEntry(st, dst, key, src.MapIndex(key))
+		st.pop()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// prepareMap sets new map to dst if it's nil or Maps mode is MapReplace
+func (c *Copier) prepareMap(dst reflect.Value, size int) {
+	if dst.IsNil() || c.Maps == MapReplace {
+		dst.Set(reflect.MakeMapWithSize(dst.Type(), size))
+	}
+}
+
+// copyMapEntry converts key to dst key type and copies value to dst map according to Maps mode
+func (c *Copier) copyMapEntry(st *state, dst, key, value reflect.Value) error {
+	dstKey, err := c.mapKey(st, dst.Type().Key(), key)
+	if err != nil {
+		return err
+	}
+	existing := dst.MapIndex(dstKey)
+	if existing.IsValid() && c.Maps == MapMergeKeepExisting {
+		return nil
+	}
+	dstValue := reflect.New(dst.Type().Elem()).Elem()
+	if existing.IsValid() && c.Maps == MapDeepMerge {
+		dstValue.Set(existing)
+	}
+	errCount := len(st.errs)
+	err = c.copyInterface(st, dstValue, value)
+	if err != nil || len(st.errs) > errCount {
+		return err
+	}
+	dst.SetMapIndex(dstKey, dstValue)
+	return nil
+}
+
+// mapKey converts src map key to dst key type through the copy pipeline if their types differ
+func (c *Copier) mapKey(st *state, keyType reflect.Type, key reflect.Value) (reflect.Value, error) {
+	if key.Type() == keyType {
+		return key, nil
+	}
+	dstKey := reflect.New(keyType).Elem()
+	err := c.copyInterface(st, dstKey, key)
+	return dstKey, err
+}
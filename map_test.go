@@ -0,0 +1,145 @@
+package copier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newMapCopier(mode MapMode) *Copier {
+	copier := New()
+	copier.Maps = mode
+	return copier
+}
+
+func Test_Copy_MapReplace(t *testing.T) {
+	dst := map[string]int{"Lorem": 100, "ipsum": 200}
+	shared := dst
+	var src = map[string]int{"ipsum": 300, "dolor": 400}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"ipsum": 300, "dolor": 400}, dst)
+	assert.Equal(t, map[string]int{"Lorem": 100, "ipsum": 200}, shared)
+}
+
+func Test_Copy_MapMergeOverwrite(t *testing.T) {
+	dst := map[string]int{"Lorem": 100, "ipsum": 200}
+	var src = map[string]int{"ipsum": 300, "dolor": 400}
+	err := newMapCopier(MapMergeOverwrite).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"Lorem": 100, "ipsum": 300, "dolor": 400}, dst)
+}
+
+func Test_Copy_MapMergeKeepExisting(t *testing.T) {
+	dst := map[string]int{"Lorem": 100, "ipsum": 200}
+	var src = map[string]int{"ipsum": 300, "dolor": 400}
+	err := newMapCopier(MapMergeKeepExisting).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"Lorem": 100, "ipsum": 200, "dolor": 400}, dst)
+}
+
+func Test_Copy_MapDeepMergeStructValues(t *testing.T) {
+	type Item struct {
+		Name  string
+		Price int
+	}
+	dst := map[string]Item{"Lorem": {Name: "Lorem", Price: 100}, "ipsum": {Name: "ipsum", Price: 200}}
+	var src = map[string]Item{"ipsum": {Price: 300}, "dolor": {Name: "dolor"}}
+	copier := newMapCopier(MapDeepMerge)
+	copier.Merge = MergeSkipZero
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]Item{
+		"Lorem": {Name: "Lorem", Price: 100},
+		"ipsum": {Name: "ipsum", Price: 300},
+		"dolor": {Name: "dolor"},
+	}, dst)
+}
+
+func Test_Copy_MapDeepMergeNestedMaps(t *testing.T) {
+	dst := map[string]interface{}{
+		"Lorem": map[string]interface{}{"ipsum": 100, "dolor": 200},
+		"sit":   "amet",
+	}
+	var src = map[string]interface{}{
+		"Lorem": map[string]interface{}{"dolor": 300, "amet": 400},
+	}
+	err := newMapCopier(MapDeepMerge).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"Lorem": map[string]interface{}{"ipsum": 100, "dolor": 300, "amet": 400},
+		"sit":   "amet",
+	}, dst)
+}
+
+func Test_Copy_StructToMapDeepMerge(t *testing.T) {
+	dst := map[string]interface{}{
+		"ID":      1,
+		"Address": map[string]interface{}{"City": "Lviv", "Zip": "79000"},
+	}
+	var src = testCustomer{ID: 100, Address: testAddress{City: "Kyiv"}}
+	err := newMapCopier(MapDeepMerge).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, 100, dst["ID"])
+	assert.Equal(t, map[string]interface{}{"City": "Kyiv", "street": "", "Zip": "79000"}, dst["Address"])
+}
+
+func Test_Copy_StructToMapKeepExisting(t *testing.T) {
+	type A struct {
+		ID   int
+		Name string
+	}
+	dst := map[string]interface{}{"ID": 1}
+	var src = A{ID: 100, Name: "Jonh"}
+	err := newMapCopier(MapMergeKeepExisting).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"ID": 1, "Name": "Jonh"}, dst)
+}
+
+func Test_Copy_MapNamedValuesToStrings(t *testing.T) {
+	type Status string
+	var dst map[string]string
+	var src = map[string]Status{"Lorem": "active"}
+	copier := New()
+	copier.ConvertNamedTypes = true
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"Lorem": "active"}, dst)
+}
+
+func Test_Copy_MapNamedValuesWithoutConversion(t *testing.T) {
+	type Status string
+	var dst map[string]string
+	var src = map[string]Status{"Lorem": "active"}
+	err := Copy(&dst, &src)
+	assert.ErrorIs(t, err, ErrDifferentTypes)
+}
+
+func Test_Copy_MapNamedKeys(t *testing.T) {
+	type Code string
+	var dst map[string]int
+	var src = map[Code]int{"Lorem": 100}
+	copier := New()
+	copier.ConvertNamedTypes = true
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"Lorem": 100}, dst)
+}
+
+func Test_Copy_MapStructValuesOfDifferentTypes(t *testing.T) {
+	type ItemA struct {
+		Name string
+		Tags []string
+	}
+	type ItemB struct {
+		Name string
+		Tags []string
+	}
+	var dst map[int]ItemB
+	var src = map[int]ItemA{1: {Name: "Lorem", Tags: []string{"ipsum"}}}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]ItemB{1: {Name: "Lorem", Tags: []string{"ipsum"}}}, dst)
+	src[1].Tags[0] = "dolor"
+	assert.Equal(t, []string{"ipsum"}, dst[1].Tags)
+}
@@ -0,0 +1,35 @@
+package copier
+
+import (
+	"reflect"
+)
+
+// MergePolicy represents which src struct fields are skipped, so they don't overwrite dst fields
+type MergePolicy int
+
+const (
+	// MergeOverwrite copies all src fields, only nil interfaces and nil pointers without converter are skipped
+	MergeOverwrite MergePolicy = iota
+	// MergeSkipNil skips nil pointers, interfaces, maps and slices
+	MergeSkipNil
+	// MergeSkipZero skips all zero values, e.g. empty strings, zero numbers and nil values
+	MergeSkipZero
+)
+
+// skipMerge reports whether src field value has to be skipped according to Merge policy or omitempty tag
+func (c *Copier) skipMerge(value reflect.Value, omitEmpty bool) bool {
+	if value.Kind() == reflect.Interface && !value.IsNil() {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Interface:
+		if value.IsNil() {
+			return true
+		}
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		if c.Merge != MergeOverwrite && value.IsNil() {
+			return true
+		}
+	}
+	return (omitEmpty || c.Merge == MergeSkipZero) && value.IsZero()
+}
@@ -0,0 +1,144 @@
+package copier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testProfile struct {
+	Bio     string
+	Website *string
+}
+
+type testUser struct {
+	Name    string
+	Age     int
+	Active  bool
+	Tags    []string
+	Profile testProfile
+	Manager *testProfile
+}
+
+type testUserPatch struct {
+	Name    string
+	Age     int
+	Active  bool
+	Tags    []string
+	Profile testProfile
+	Manager *testProfile
+}
+
+func newTestUser() testUser {
+	website := "example.com"
+	return testUser{
+		Name:    "Jonh",
+		Age:     30,
+		Active:  true,
+		Tags:    []string{"Lorem"},
+		Profile: testProfile{Bio: "ipsum", Website: &website},
+		Manager: &testProfile{Bio: "dolor"},
+	}
+}
+
+func Test_Copy_MergeOverwrite(t *testing.T) {
+	dst := newTestUser()
+	var src = testUserPatch{Name: "Bill"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bill", dst.Name)
+	assert.Equal(t, 0, dst.Age)
+	assert.False(t, dst.Active)
+	assert.Equal(t, "", dst.Profile.Bio)
+	assert.Equal(t, &testProfile{Bio: "dolor"}, dst.Manager)
+}
+
+func Test_Copy_MergeSkipZero(t *testing.T) {
+	dst := newTestUser()
+	var src = testUserPatch{Name: "Bill", Profile: testProfile{Bio: "amet"}, Manager: &testProfile{}}
+	copier := New()
+	copier.Merge = MergeSkipZero
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	expected := newTestUser()
+	expected.Name = "Bill"
+	expected.Profile.Bio = "amet"
+	assert.Equal(t, expected, dst)
+}
+
+func Test_Copy_MergeSkipNil(t *testing.T) {
+	dst := newTestUser()
+	var src = testUserPatch{Name: "Bill"}
+	copier := New()
+	copier.Merge = MergeSkipNil
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bill", dst.Name)
+	assert.Equal(t, 0, dst.Age)
+	assert.Equal(t, []string{"Lorem"}, dst.Tags)
+	assert.Equal(t, &testProfile{Bio: "dolor"}, dst.Manager)
+	assert.Equal(t, "example.com", *dst.Profile.Website)
+}
+
+func Test_Copy_MergeOmitEmptyTag(t *testing.T) {
+	type Patch struct {
+		Name string `copier:",omitempty"`
+		Age  int
+	}
+	type User struct {
+		Name   string
+		Age    int
+		Active bool `copier:",omitempty"`
+	}
+	dst := User{Name: "Jonh", Age: 30, Active: true}
+	var src = Patch{Age: 31}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, User{Name: "Jonh", Age: 31, Active: true}, dst)
+}
+
+func Test_Copy_MergeMapToStruct(t *testing.T) {
+	dst := newTestUser()
+	var src = map[string]interface{}{"Name": "", "Age": 31, "Tags": nil}
+	copier := New()
+	copier.Merge = MergeSkipZero
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	expected := newTestUser()
+	expected.Age = 31
+	assert.Equal(t, expected, dst)
+}
+
+func Test_Copy_MergeStructToMap(t *testing.T) {
+	type S struct {
+		Name string `copier:",omitempty"`
+		Age  int
+		Tags []string
+	}
+	dst := map[string]interface{}{"Name": "keep", "Tags": []string{"Lorem"}}
+	var src = S{Age: 3}
+	copier := New()
+	copier.Maps = MapMergeOverwrite
+	copier.Merge = MergeSkipNil
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Name": "keep", "Age": 3, "Tags": []string{"Lorem"}}, dst)
+
+	copier.Merge = MergeSkipZero
+	err = copier.Copy(&dst, &S{})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"Name": "keep", "Age": 3, "Tags": []string{"Lorem"}}, dst)
+}
+
+func Test_Copy_MergeStructToDocument(t *testing.T) {
+	type S struct {
+		Name string `bson:"name" copier:",omitempty"`
+		Age  int    `bson:"age"`
+	}
+	var dst primitive.D
+	var src = S{Age: 3}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, primitive.D{{Key: "age", Value: 3}}, dst)
+}
@@ -0,0 +1,174 @@
+package copier
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// decimal128Precision is precision of big.Float enough for all 34 digits of Decimal128
+const decimal128Precision = 113
+
+var (
+	// Decimal128ToStringConverter is converter for copier,
+	// which realize mongo Decimal128 to string convertation.
+	Decimal128ToStringConverter = NewConverter(func(src primitive.Decimal128) (string, error) {
+		return src.String(), nil
+	})
+
+	// StringToDecimal128Converter is converter for copier,
+	// which realize string to mongo Decimal128 convertation.
+	StringToDecimal128Converter = NewConverter(func(src string) (primitive.Decimal128, error) {
+		d, err := primitive.ParseDecimal128(src)
+		if err != nil {
+			return primitive.Decimal128{}, fmt.Errorf("failed get Decimal128 from string: %v", err)
+		}
+		return d, nil
+	})
+
+	// Decimal128ToBigFloatConverter is converter for copier,
+	// which realize mongo Decimal128 to *big.Float convertation.
+	Decimal128ToBigFloatConverter = NewConverter(func(src primitive.Decimal128) (*big.Float, error) {
+		if src.IsNaN() {
+			return nil, fmt.Errorf("failed get big.Float from Decimal128: NaN")
+		}
+		if inf := src.IsInf(); inf != 0 {
+			return new(big.Float).SetInf(inf < 0), nil
+		}
+		f, _, err := new(big.Float).SetPrec(decimal128Precision).Parse(src.String(), 10)
+		if err != nil {
+			return nil, fmt.Errorf("failed get big.Float from Decimal128: %v", err)
+		}
+		return f, nil
+	})
+
+	// BigFloatToDecimal128Converter is converter for copier,
+	// which realize *big.Float to mongo Decimal128 convertation, nil is converted to zero.
+	BigFloatToDecimal128Converter = NewConverter(func(src *big.Float) (primitive.Decimal128, error) {
+		if src == nil {
+			return primitive.NewDecimal128(0, 0), nil
+		}
+		d, err := primitive.ParseDecimal128(src.Text('g', 34))
+		if err != nil {
+			return primitive.Decimal128{}, fmt.Errorf("failed get Decimal128 from big.Float: %v", err)
+		}
+		return d, nil
+	})
+
+	// BinaryToUUIDConverter is converter for copier,
+	// which realize mongo Binary of UUID subtype to [16]byte convertation.
+	BinaryToUUIDConverter = NewConverter(binaryToUUID)
+
+	// UUIDToBinaryConverter is converter for copier,
+	// which realize [16]byte to mongo Binary of UUID subtype convertation.
+	UUIDToBinaryConverter = NewConverter(func(src [16]byte) (primitive.Binary, error) {
+		return primitive.Binary{Subtype: bsontype.BinaryUUID, Data: src[:]}, nil
+	})
+
+	// BinaryToUUIDStringConverter is converter for copier,
+	// which realize mongo Binary of UUID subtype to canonical UUID string convertation,
+	// empty Binary is converted to empty string.
+	BinaryToUUIDStringConverter = NewConverter(func(src primitive.Binary) (string, error) {
+		if len(src.Data) == 0 {
+			return "", nil
+		}
+		uuid, err := binaryToUUID(src)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:16]), nil
+	})
+
+	// UUIDStringToBinaryConverter is converter for copier,
+	// which realize UUID string to mongo Binary of UUID subtype convertation,
+	// empty string is converted to empty Binary.
+	UUIDStringToBinaryConverter = NewConverter(func(src string) (primitive.Binary, error) {
+		if src == "" {
+			return primitive.Binary{}, nil
+		}
+		data, err := hex.DecodeString(strings.ReplaceAll(src, "-", ""))
+		if err != nil || len(data) != 16 {
+			return primitive.Binary{}, fmt.Errorf("failed get UUID from string: %q", src)
+		}
+		return primitive.Binary{Subtype: bsontype.BinaryUUID, Data: data}, nil
+	})
+
+	// TimestampToTimeConverter is converter for copier,
+	// which realize mongo Timestamp to UTC time.Time convertation, increment is dropped.
+	TimestampToTimeConverter = NewConverter(func(src primitive.Timestamp) (time.Time, error) {
+		return time.Unix(int64(src.T), 0).UTC(), nil
+	})
+
+	// TimeToTimestampConverter is converter for copier,
+	// which realize time.Time to mongo Timestamp convertation with zero increment.
+	TimeToTimestampConverter = NewConverter(func(src time.Time) (primitive.Timestamp, error) {
+		sec := src.Unix()
+		if sec < 0 || sec > math.MaxUint32 {
+			return primitive.Timestamp{}, fmt.Errorf("%w: %v overflows Timestamp", ErrNumberOverflow, src)
+		}
+		return primitive.Timestamp{T: uint32(sec)}, nil
+	})
+
+	// ObjectIDPtrToStringConverter is converter for copier,
+	// which realize *ObjectID to string convertation, nil is converted to empty string.
+	ObjectIDPtrToStringConverter = NewConverter(func(src *primitive.ObjectID) (string, error) {
+		if src == nil {
+			return "", nil
+		}
+		return src.Hex(), nil
+	})
+
+	// StringToObjectIDPtrConverter is converter for copier,
+	// which realize string to *ObjectID convertation, empty string is converted to nil.
+	StringToObjectIDPtrConverter = NewConverter(func(src string) (*primitive.ObjectID, error) {
+		if src == "" {
+			return nil, nil
+		}
+		id, err := primitive.ObjectIDFromHex(src)
+		if err != nil {
+			return nil, fmt.Errorf("failed get ObjectID from string: %v", err)
+		}
+		return &id, nil
+	})
+)
+
+// MongoConverters returns converters of mongo BSON primitives to Go types and back
+func MongoConverters() []Converter {
+	return []Converter{
+		objectIDToStringConverter,
+		stringToObjectIDConverter,
+		ObjectIDPtrToStringConverter,
+		StringToObjectIDPtrConverter,
+		DateTimeToTimeConverter,
+		TimeToDateTimeConverter,
+		Decimal128ToStringConverter,
+		StringToDecimal128Converter,
+		Decimal128ToBigFloatConverter,
+		BigFloatToDecimal128Converter,
+		BinaryToUUIDConverter,
+		UUIDToBinaryConverter,
+		BinaryToUUIDStringConverter,
+		UUIDStringToBinaryConverter,
+		TimestampToTimeConverter,
+		TimeToTimestampConverter,
+	}
+}
+
+// binaryToUUID returns UUID bytes of mongo Binary with UUID subtype
+func binaryToUUID(src primitive.Binary) ([16]byte, error) {
+	var uuid [16]byte
+	if src.Subtype != bsontype.BinaryUUID && src.Subtype != bsontype.BinaryUUIDOld {
+		return uuid, fmt.Errorf("failed get UUID from Binary: unexpected subtype %#x", src.Subtype)
+	}
+	if len(src.Data) != len(uuid) {
+		return uuid, fmt.Errorf("failed get UUID from Binary: unexpected length %d", len(src.Data))
+	}
+	copy(uuid[:], src.Data)
+	return uuid, nil
+}
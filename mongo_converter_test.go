@@ -0,0 +1,135 @@
+package copier
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testMongoDocument struct {
+	ID        primitive.ObjectID
+	ParentID  *primitive.ObjectID
+	CreatedAt primitive.DateTime
+	Price     primitive.Decimal128
+	Total     primitive.Decimal128
+	UUID      primitive.Binary
+	Key       primitive.Binary
+	Version   primitive.Timestamp
+}
+
+type testMongoDTO struct {
+	ID        string
+	ParentID  string
+	CreatedAt time.Time
+	Price     string
+	Total     *big.Float
+	UUID      [16]byte
+	Key       string
+	Version   time.Time
+}
+
+func Test_MongoConverters_RoundTrip(t *testing.T) {
+	parentID := primitive.NewObjectID()
+	price, err := primitive.ParseDecimal128("10.50")
+	assert.NoError(t, err)
+	total, err := primitive.ParseDecimal128("1.5E+3")
+	assert.NoError(t, err)
+	var src = testMongoDocument{
+		ID:        primitive.NewObjectID(),
+		ParentID:  &parentID,
+		CreatedAt: primitive.NewDateTimeFromTime(time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC)),
+		Price:     price,
+		Total:     total,
+		UUID:      primitive.Binary{Subtype: bsontype.BinaryUUID, Data: []byte("0123456789abcdef")},
+		Key: primitive.Binary{Subtype: bsontype.BinaryUUID, Data: []byte{
+			0x12, 0x3e, 0x45, 0x67, 0xe8, 0x9b, 0x12, 0xd3, 0xa4, 0x56, 0x42, 0x66, 0x14, 0x17, 0x40, 0x00,
+		}},
+		Version: primitive.Timestamp{T: 1682937000},
+	}
+	converters := MongoConverters()
+	dto, err := CopyTo[testMongoDTO](src, converters...)
+	assert.NoError(t, err)
+	assert.Equal(t, src.ID.Hex(), dto.ID)
+	assert.Equal(t, parentID.Hex(), dto.ParentID)
+	assert.Equal(t, time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC), dto.CreatedAt)
+	assert.Equal(t, "10.50", dto.Price)
+	assert.Equal(t, "1500", dto.Total.Text('f', -1))
+	assert.Equal(t, [16]byte{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9', 'a', 'b', 'c', 'd', 'e', 'f'}, dto.UUID)
+	assert.Equal(t, "123e4567-e89b-12d3-a456-426614174000", dto.Key)
+	assert.Equal(t, time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC), dto.Version)
+
+	dst, err := CopyTo[testMongoDocument](dto, converters...)
+	assert.NoError(t, err)
+	assert.Equal(t, src.ID, dst.ID)
+	assert.Equal(t, parentID, *dst.ParentID)
+	assert.Equal(t, src.CreatedAt, dst.CreatedAt)
+	assert.Equal(t, src.Price.String(), dst.Price.String())
+	assert.Equal(t, "1500", dst.Total.String())
+	assert.Equal(t, src.UUID, dst.UUID)
+	assert.Equal(t, src.Key, dst.Key)
+	assert.Equal(t, src.Version, dst.Version)
+}
+
+func Test_MongoConverters_NilObjectIDPtr(t *testing.T) {
+	type A struct {
+		ParentID string
+	}
+	type B struct {
+		ParentID *primitive.ObjectID
+	}
+	parentID := primitive.NewObjectID()
+	b := B{ParentID: &parentID}
+	err := Copy(&b, &A{}, MongoConverters()...)
+	assert.NoError(t, err)
+	assert.Nil(t, b.ParentID)
+
+	a := A{ParentID: parentID.Hex()}
+	err = Copy(&a, &B{}, MongoConverters()...)
+	assert.NoError(t, err)
+	assert.Equal(t, A{}, a)
+
+	err = Copy(&b, &A{ParentID: "Lorem"}, MongoConverters()...)
+	assert.EqualError(t, err, "ParentID: failed get ObjectID from string: the provided hex string is not a valid ObjectID")
+}
+
+func Test_MongoConverters_Decimal128(t *testing.T) {
+	nan, err := primitive.ParseDecimal128("NaN")
+	assert.NoError(t, err)
+	var f *big.Float
+	err = Copy(&f, &nan, Decimal128ToBigFloatConverter)
+	assert.EqualError(t, err, "failed get big.Float from Decimal128: NaN")
+
+	inf, err := primitive.ParseDecimal128("-Infinity")
+	assert.NoError(t, err)
+	err = Copy(&f, &inf, Decimal128ToBigFloatConverter)
+	assert.NoError(t, err)
+	assert.True(t, f.IsInf())
+
+	var d primitive.Decimal128
+	var src = "Lorem"
+	err = Copy(&d, &src, StringToDecimal128Converter)
+	assert.EqualError(t, err, `failed get Decimal128 from string: cannot parse "Lorem" as a decimal128`)
+}
+
+func Test_MongoConverters_InvalidUUID(t *testing.T) {
+	var dst [16]byte
+	var src = primitive.Binary{Subtype: bsontype.BinaryGeneric, Data: make([]byte, 16)}
+	err := Copy(&dst, &src, BinaryToUUIDConverter)
+	assert.EqualError(t, err, "failed get UUID from Binary: unexpected subtype 0x0")
+
+	var bin primitive.Binary
+	var str = "Lorem"
+	err = Copy(&bin, &str, UUIDStringToBinaryConverter)
+	assert.EqualError(t, err, `failed get UUID from string: "Lorem"`)
+}
+
+func Test_MongoConverters_TimestampOverflow(t *testing.T) {
+	var dst primitive.Timestamp
+	var src = time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := Copy(&dst, &src, TimeToTimestampConverter)
+	assert.ErrorIs(t, err, ErrNumberOverflow)
+}
@@ -0,0 +1,34 @@
+package copier
+
+import (
+	"reflect"
+)
+
+// NamedConversion represents pair of distinct named types, which are allowed to be converted
+// from Src to Dst by Copier with ConvertNamedTypes enabled, e.g. two enum types over int
+type NamedConversion struct {
+	Src interface{}
+	Dst interface{}
+}
+
+// isDefinedType reports whether t is declared by user, unlike predeclared and unnamed types
+func isDefinedType(t reflect.Type) bool {
+	return t.Name() != "" && t.PkgPath() != ""
+}
+
+// canConvertNamed reports whether src can be converted to dst type with ConvertNamedTypes.
+// Conversions between two distinct defined types have to be listed in NamedConversions.
+func (c *Copier) canConvertNamed(dst, src reflect.Type) bool {
+	if !c.ConvertNamedTypes || src.Kind() != dst.Kind() || !src.ConvertibleTo(dst) {
+		return false
+	}
+	if !isDefinedType(src) || !isDefinedType(dst) {
+		return true
+	}
+	for _, conversion := range c.NamedConversions {
+		if reflect.TypeOf(conversion.Src) == src && reflect.TypeOf(conversion.Dst) == dst {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,84 @@
+package copier
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testStatus string
+
+type testColor int
+
+type testSize int
+
+func newNamedCopier() *Copier {
+	copier := New()
+	copier.ConvertNamedTypes = true
+	return copier
+}
+
+func Test_Copy_NamedTypeWithoutConversion(t *testing.T) {
+	var dst string
+	var src = testStatus("active")
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "src and dst fields has different types: expected copier.testStatus, actual string")
+}
+
+func Test_Copy_NamedTypeToUnderlyingType(t *testing.T) {
+	type A struct {
+		Status testStatus
+		Color  testColor
+		Tags   []testStatus
+	}
+	type B struct {
+		Status string
+		Color  int
+		Tags   []string
+	}
+	var dst B
+	var src = A{Status: "active", Color: 3, Tags: []testStatus{"new", "sale"}}
+	err := newNamedCopier().Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Status: "active", Color: 3, Tags: []string{"new", "sale"}}, dst)
+}
+
+func Test_Copy_UnderlyingTypeToNamedType(t *testing.T) {
+	var dst []*testStatus
+	var src = []string{"active"}
+	err := newNamedCopier().Copy(&dst, &src)
+	assert.NoError(t, err)
+	status := testStatus("active")
+	assert.Equal(t, []*testStatus{&status}, dst)
+}
+
+func Test_Copy_NamedTypeToNamedTypeBlocked(t *testing.T) {
+	var dst testSize
+	var src = testColor(3)
+	err := newNamedCopier().Copy(&dst, &src)
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+	assert.Equal(t, testSize(0), dst)
+}
+
+func Test_Copy_NamedTypeToNamedTypeAllowed(t *testing.T) {
+	var dst testSize
+	var src = testColor(3)
+	copier := newNamedCopier()
+	copier.NamedConversions = []NamedConversion{{Src: testColor(0), Dst: testSize(0)}}
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, testSize(3), dst)
+
+	var back testColor
+	err = copier.Copy(&back, &dst)
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+}
+
+func Test_Copy_NamedTypeDifferentKinds(t *testing.T) {
+	var dst string
+	var src = testColor(65)
+	err := newNamedCopier().Copy(&dst, &src)
+	assert.True(t, errors.Is(err, ErrDifferentTypes))
+	assert.Equal(t, "", dst)
+}
@@ -0,0 +1,215 @@
+package copier
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// OverflowPolicy represents behavior of Copier when converted number doesn't fit dst type
+type OverflowPolicy int
+
+const (
+	// OverflowError returns error wrapping ErrNumberOverflow
+	OverflowError OverflowPolicy = iota
+	// OverflowSaturate sets the closest value which fits dst type, e.g. 300 to uint8 is 255
+	OverflowSaturate
+	// OverflowWrap keeps low-order bits like Go conversion does, e.g. 300 to uint8 is 44
+	OverflowWrap
+)
+
+// numberClass represents group of numeric kinds with the same representation
+type numberClass int
+
+const (
+	notNumber numberClass = iota
+	intNumber
+	uintNumber
+	floatNumber
+)
+
+func classOf(kind reflect.Kind) numberClass {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return intNumber
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uintNumber
+	case reflect.Float32, reflect.Float64:
+		return floatNumber
+	default:
+		return notNumber
+	}
+}
+
+// isNumberConversion reports whether src can be copied to dst with number conversion
+func isNumberConversion(dst, src reflect.Type) bool {
+	return classOf(dst.Kind()) != notNumber && classOf(src.Kind()) != notNumber
+}
+
+// convertNumber converts int, uint or float src to int, uint or float dst
+func (c *Copier) convertNumber(dst, src reflect.Value) error {
+	switch classOf(dst.Kind()) {
+	case intNumber:
+		return c.convertToInt(dst, src)
+	case uintNumber:
+		return c.convertToUint(dst, src)
+	default:
+		return c.convertToFloat(dst, src)
+	}
+}
+
+func (c *Copier) convertToInt(dst, src reflect.Value) error {
+	bits := dst.Type().Bits()
+	min := int64(-1) << (bits - 1)
+	max := int64(1)<<(bits-1) - 1
+	switch classOf(src.Kind()) {
+	case intNumber:
+		v := src.Int()
+		if v < min || v > max {
+			return c.overflow(dst, v,
+				func() { dst.SetInt(clampInt(v, min, max)) },
+				func() { dst.SetInt(v) })
+		}
+		dst.SetInt(v)
+	case uintNumber:
+		v := src.Uint()
+		if v > uint64(max) {
+			return c.overflow(dst, v,
+				func() { dst.SetInt(max) },
+				func() { dst.SetInt(int64(v)) })
+		}
+		dst.SetInt(int64(v))
+	default:
+		f, err := c.truncate(dst, src.Float())
+		if err != nil {
+			return err
+		}
+		if f < float64(min) || f >= float64(max)+1 {
+			return c.overflow(dst, f,
+				func() { dst.SetInt(clampFloat(f, min, max)) },
+				func() { dst.SetInt(int64(wrapFloat(f))) })
+		}
+		dst.SetInt(int64(f))
+	}
+	return nil
+}
+
+func (c *Copier) convertToUint(dst, src reflect.Value) error {
+	max := uint64(math.MaxUint64) >> (64 - dst.Type().Bits())
+	switch classOf(src.Kind()) {
+	case intNumber:
+		v := src.Int()
+		if v < 0 || uint64(v) > max {
+			return c.overflow(dst, v,
+				func() { dst.SetUint(clampUint(v < 0, max)) },
+				func() { dst.SetUint(uint64(v)) })
+		}
+		dst.SetUint(uint64(v))
+	case uintNumber:
+		v := src.Uint()
+		if v > max {
+			return c.overflow(dst, v,
+				func() { dst.SetUint(max) },
+				func() { dst.SetUint(v) })
+		}
+		dst.SetUint(v)
+	default:
+		f, err := c.truncate(dst, src.Float())
+		if err != nil {
+			return err
+		}
+		if f < 0 || f >= float64(max)+1 {
+			return c.overflow(dst, f,
+				func() { dst.SetUint(clampUint(f < 0, max)) },
+				func() { dst.SetUint(wrapFloat(f)) })
+		}
+		dst.SetUint(uint64(f))
+	}
+	return nil
+}
+
+func (c *Copier) convertToFloat(dst, src reflect.Value) error {
+	switch classOf(src.Kind()) {
+	case intNumber:
+		dst.SetFloat(float64(src.Int()))
+	case uintNumber:
+		dst.SetFloat(float64(src.Uint()))
+	default:
+		f := src.Float()
+		if dst.Kind() == reflect.Float32 && !math.IsInf(f, 0) && math.Abs(f) > math.MaxFloat32 {
+			return c.overflow(dst, f,
+				func() { dst.SetFloat(math.Copysign(math.MaxFloat32, f)) },
+				func() { dst.SetFloat(f) })
+		}
+		dst.SetFloat(f)
+	}
+	return nil
+}
+
+// truncate drops fractional part of f, if it's allowed by AllowPrecisionLoss
+func (c *Copier) truncate(dst reflect.Value, f float64) (float64, error) {
+	if math.IsNaN(f) {
+		return 0, fmt.Errorf("%w: %v to %s", ErrPrecisionLoss, f, dst.Type())
+	}
+	t := math.Trunc(f)
+	if t != f && !c.AllowPrecisionLoss {
+		return 0, fmt.Errorf("%w: %v to %s", ErrPrecisionLoss, f, dst.Type())
+	}
+	return t, nil
+}
+
+// overflow handles number which doesn't fit dst type according to NumberOverflow policy
+func (c *Copier) overflow(dst reflect.Value, value interface{}, saturate, wrap func()) error {
+	switch c.NumberOverflow {
+	case OverflowSaturate:
+		saturate()
+	case OverflowWrap:
+		wrap()
+	default:
+		return fmt.Errorf("%w: %v overflows %s", ErrNumberOverflow, value, dst.Type())
+	}
+	return nil
+}
+
+func clampInt(v, min, max int64) int64 {
+	switch {
+	case v < min:
+		return min
+	case v > max:
+		return max
+	default:
+		return v
+	}
+}
+
+func clampFloat(f float64, min, max int64) int64 {
+	if f < 0 {
+		return min
+	}
+	return max
+}
+
+func clampUint(negative bool, max uint64) uint64 {
+	if negative {
+		return 0
+	}
+	return max
+}
+
+// wrapFloat returns low-order 64 bits of integer float value
+func wrapFloat(f float64) uint64 {
+	if math.IsInf(f, 0) {
+		return 0
+	}
+	w := math.Mod(f, 1<<64)
+	switch {
+	case w >= 0:
+		return uint64(w)
+	case w >= math.MinInt64:
+		// two's complement of small negative values, adding 1<<64 would round them to 1<<64
+		return uint64(int64(w))
+	default:
+		// w+1<<64 is below 1<<63 and exact, because w has no fractional bits at this magnitude
+		return uint64(w + 1<<64)
+	}
+}
@@ -0,0 +1,189 @@
+package copier
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newNumberCopier(policy OverflowPolicy) *Copier {
+	copier := New()
+	copier.ConvertNumbers = true
+	copier.NumberOverflow = policy
+	return copier
+}
+
+func Test_Copy_NumbersWithoutConversion(t *testing.T) {
+	var dst int64
+	var src = 100
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "src and dst fields has different types: expected int, actual int64")
+}
+
+func Test_Copy_NumbersWidening(t *testing.T) {
+	type A struct {
+		Int   int
+		Int32 int32
+		Uint8 uint8
+		Float float32
+	}
+	type B struct {
+		Int   int64
+		Int32 float64
+		Uint8 int
+		Float float64
+	}
+	var dst B
+	var src = A{Int: 100, Int32: -200, Uint8: 255, Float: 1.5}
+	err := newNumberCopier(OverflowError).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Int: 100, Int32: -200, Uint8: 255, Float: 1.5}, dst)
+}
+
+func Test_Copy_NumbersNarrowing(t *testing.T) {
+	type A struct {
+		Int8   int64
+		Uint16 int
+		Int    float64
+		Uint   float32
+	}
+	type B struct {
+		Int8   int8
+		Uint16 uint16
+		Int    int
+		Uint   uint
+	}
+	var dst B
+	var src = A{Int8: -128, Uint16: 65535, Int: -100, Uint: 100}
+	err := newNumberCopier(OverflowError).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Int8: -128, Uint16: 65535, Int: -100, Uint: 100}, dst)
+}
+
+func Test_Copy_NumbersOverflowError(t *testing.T) {
+	testCases := []struct {
+		name string
+		dst  interface{}
+		src  interface{}
+		err  string
+	}{
+		{name: "int to int8", dst: new(int8), src: 300, err: "number overflow: 300 overflows int8"},
+		{name: "negative int to uint", dst: new(uint), src: -1, err: "number overflow: -1 overflows uint"},
+		{name: "uint64 to int64", dst: new(int64), src: uint64(math.MaxUint64), err: "number overflow: 18446744073709551615 overflows int64"},
+		{name: "uint to uint8", dst: new(uint8), src: uint(256), err: "number overflow: 256 overflows uint8"},
+		{name: "float to int16", dst: new(int16), src: 40000.0, err: "number overflow: 40000 overflows int16"},
+		{name: "float to uint", dst: new(uint32), src: -1.0, err: "number overflow: -1 overflows uint32"},
+		{name: "float64 to float32", dst: new(float32), src: math.MaxFloat64, err: "number overflow: 1.7976931348623157e+308 overflows float32"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			src := reflect.New(reflect.TypeOf(tc.src))
+			src.Elem().Set(reflect.ValueOf(tc.src))
+			err := newNumberCopier(OverflowError).Copy(tc.dst, src.Interface())
+			assert.EqualError(t, err, tc.err)
+			assert.True(t, errors.Is(err, ErrNumberOverflow))
+		})
+	}
+}
+
+func Test_Copy_NumbersOverflowSaturate(t *testing.T) {
+	type A struct {
+		Int8    int
+		Uint8   int
+		Uint16  uint64
+		Int32   float64
+		Uint    float64
+		Float32 float64
+	}
+	type B struct {
+		Int8    int8
+		Uint8   uint8
+		Uint16  uint16
+		Int32   int32
+		Uint    uint
+		Float32 float32
+	}
+	var dst B
+	var src = A{Int8: -300, Uint8: -1, Uint16: 70000, Int32: 1e10, Uint: 1e30, Float32: -math.MaxFloat64}
+	err := newNumberCopier(OverflowSaturate).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{
+		Int8:    math.MinInt8,
+		Uint8:   0,
+		Uint16:  math.MaxUint16,
+		Int32:   math.MaxInt32,
+		Uint:    math.MaxUint,
+		Float32: -math.MaxFloat32,
+	}, dst)
+}
+
+func Test_Copy_NumbersOverflowWrap(t *testing.T) {
+	type A struct {
+		Uint8  int
+		Int8   uint
+		Int16  float64
+		Uint32 int64
+	}
+	type B struct {
+		Uint8  uint8
+		Int8   int8
+		Int16  int16
+		Uint32 uint32
+	}
+	var dst B
+	var src = A{Uint8: 300, Int8: 200, Int16: 40000, Uint32: -1}
+	err := newNumberCopier(OverflowWrap).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Uint8: 44, Int8: -56, Int16: -25536, Uint32: math.MaxUint32}, dst)
+}
+
+func Test_Copy_NumbersOverflowWrapNegativeFloat(t *testing.T) {
+	type A struct {
+		Int8   float64
+		Uint8  float64
+		Uint64 float64
+		Int64  float64
+	}
+	type B struct {
+		Int8   int8
+		Uint8  uint8
+		Uint64 uint64
+		Int64  int64
+	}
+	var dst B
+	var src = A{Int8: -200, Uint8: -1, Uint64: -1e19, Int64: -1e19}
+	err := newNumberCopier(OverflowWrap).Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Int8: 56, Uint8: 255, Uint64: 8446744073709551616, Int64: 8446744073709551616}, dst)
+}
+
+func Test_Copy_NumbersPrecisionLoss(t *testing.T) {
+	var dst int
+	var src = 1.5
+	err := newNumberCopier(OverflowError).Copy(&dst, &src)
+	assert.EqualError(t, err, "number precision loss: 1.5 to int")
+	assert.True(t, errors.Is(err, ErrPrecisionLoss))
+	assert.Equal(t, 0, dst)
+}
+
+func Test_Copy_NumbersNaN(t *testing.T) {
+	var dst int
+	var src = math.NaN()
+	copier := newNumberCopier(OverflowSaturate)
+	copier.AllowPrecisionLoss = true
+	err := copier.Copy(&dst, &src)
+	assert.True(t, errors.Is(err, ErrPrecisionLoss))
+}
+
+func Test_Copy_NumbersAllowPrecisionLoss(t *testing.T) {
+	var dst []int
+	var src = []float64{1.5, -2.9, 3}
+	copier := newNumberCopier(OverflowError)
+	copier.AllowPrecisionLoss = true
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, -2, 3}, dst)
+}
@@ -0,0 +1,317 @@
+package copier
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// planKind represents the way values of src type are copied to dst type
+type planKind int
+
+const (
+	planElement planKind = iota
+	planConvert
+	planPtr
+	planDstPtr
+	planInterface
+	planDstInterface
+	planSliceArray
+	planMap
+	planStruct
+	planMapToStruct
+	planStructToMap
+	planAtomic
+	planDocument
+	planRaw
+	planStructToDocument
+)
+
+// typePair represents key of copy plan
+type typePair struct {
+	dst reflect.Type
+	src reflect.Type
+}
+
+// fieldPlan represents copy of single src struct field to matched dst struct field
+type fieldPlan struct {
+	src        fieldInfo
+	dst        fieldInfo
+	matched    bool
+	unexported bool
+}
+
+// plan represents reusable copy plan of src type to dst type
+type plan struct {
+	kind       planKind
+	converter  *Converter
+	fields     []fieldPlan
+	required   []fieldInfo
+	unexported bool
+}
+
+// plan returns cached copy plan for dst and src types, compiling it on first use
+func (c *Copier) plan(dst, src reflect.Type) *plan {
+	key := typePair{dst: dst, src: src}
+	if p, ok := c.plans.Load(key); ok {
+		return p.(*plan)
+	}
+	p, _ := c.plans.LoadOrStore(key, c.compile(dst, src))
+	return p.(*plan)
+}
+
+// planSettings represents Copier settings read by compile, plans compiled with other settings are stale
+type planSettings struct {
+	converters []converterKey
+	srcNames   unsafe.Pointer
+	dstNames   unsafe.Pointer
+	atomics    uint64
+}
+
+// converterKey identifies converter by its types and convert function
+type converterKey struct {
+	src     reflect.Type
+	dst     reflect.Type
+	convert unsafe.Pointer
+}
+
+func newConverterKey(cv *Converter) converterKey {
+	return converterKey{src: cv.SrcType(), dst: cv.DstType(), convert: funcPointer(cv.Convert)}
+}
+
+// funcPointer returns pointer to closure of fn, so distinct closures of the same function are distinguished
+func funcPointer[F any](fn F) unsafe.Pointer {
+	return *(*unsafe.Pointer)(unsafe.Pointer(&fn))
+}
+
+// currentSettings returns settings of c read by compile
+func (c *Copier) currentSettings() *planSettings {
+	s := &planSettings{
+		converters: make([]converterKey, len(c.Converters)),
+		srcNames:   funcPointer(c.SrcFieldNames),
+		dstNames:   funcPointer(c.DstFieldNames),
+		atomics:    atomicsVersion.Load(),
+	}
+	for i := range c.Converters {
+		s.converters[i] = newConverterKey(&c.Converters[i])
+	}
+	return s
+}
+
+// matches reports whether settings of c are the same as s
+func (s *planSettings) matches(c *Copier) bool {
+	return s.atomics == atomicsVersion.Load() &&
+		s.srcNames == funcPointer(c.SrcFieldNames) &&
+		s.dstNames == funcPointer(c.DstFieldNames) &&
+		s.matchesConverters(c.Converters)
+}
+
+// matchesConverters reports whether cc are the same converters as converters of s
+func (s *planSettings) matchesConverters(cc []Converter) bool {
+	if len(cc) != len(s.converters) {
+		return false
+	}
+	for i := range cc {
+		if newConverterKey(&cc[i]) != s.converters[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkPlans drops cached plans if Converters, field name resolvers or atomic types
+// have been changed since plans were compiled, so exported settings can be assigned directly
+func (c *Copier) checkPlans() {
+	if s := c.settings.Load(); s != nil && s.matches(c) {
+		return
+	}
+	c.resetPlans()
+	c.settings.Store(c.currentSettings())
+}
+
+// resetPlans drops all cached plans, it's required after changing of Copier settings
+func (c *Copier) resetPlans() {
+	c.plans.Range(func(key, _ interface{}) bool {
+		c.plans.Delete(key)
+		return true
+	})
+}
+
+func (c *Copier) compile(dst, src reflect.Type) *plan {
+	for i := range c.Converters {
+		if c.Converters[i].SrcType() == src && c.Converters[i].DstType() == dst {
+			converter := c.Converters[i]
+			return &plan{kind: planConvert, converter: &converter}
+		}
+	}
+	if kind, ok := specialKind(dst, src); ok {
+		return &plan{kind: kind}
+	}
+	switch {
+	case src.Kind() == reflect.Interface:
+		return &plan{kind: planInterface}
+	case dst.Kind() == reflect.Interface:
+		return &plan{kind: planDstInterface}
+	case src.Kind() == reflect.Ptr:
+		return &plan{kind: planPtr}
+	case dst.Kind() == reflect.Ptr:
+		return &plan{kind: planDstPtr}
+	}
+	switch src.Kind() {
+	case reflect.Slice, reflect.Array:
+		return &plan{kind: planSliceArray}
+	case reflect.Map:
+		if dst.Kind() == reflect.Struct {
+			return &plan{kind: planMapToStruct}
+		}
+		return &plan{kind: planMap}
+	case reflect.Struct:
+		switch dst.Kind() {
+		case reflect.Struct:
+			return c.compileStruct(dst, src)
+		case reflect.Map:
+			return &plan{kind: planStructToMap}
+		default:
+			return &plan{kind: planStruct}
+		}
+	default:
+		return &plan{kind: planElement}
+	}
+}
+
+// specialKind returns plan kind of BSON documents and atomic types, which aren't copied by their kind.
+// Atomic values are copied after pointers and interfaces are resolved.
+func specialKind(dst, src reflect.Type) (planKind, bool) {
+	switch {
+	case src == bsonRawType && isRawTarget(dst):
+		return planRaw, true
+	case src == bsonDType && (dst.Kind() == reflect.Struct || dst.Kind() == reflect.Map):
+		return planDocument, true
+	case src.Kind() == reflect.Struct && dst == bsonDType && !isAtomic(src):
+		return planStructToDocument, true
+	case isIndirect(src) || isIndirect(dst):
+		return 0, false
+	case dst == src && isAtomic(src):
+		return planAtomic, true
+	case (isAtomic(src) || isAtomic(dst)) && (src.Kind() == reflect.Struct || dst.Kind() == reflect.Struct):
+		// atomic structs are never walked field by field, even if types differ
+		return planAtomic, true
+	default:
+		return 0, false
+	}
+}
+
+// isIndirect reports whether t is pointer or interface type
+func isIndirect(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface
+}
+
+func (c *Copier) compileStruct(dst, src reflect.Type) *plan {
+	p := &plan{kind: planStruct}
+	srcInfo := getStructInfo(src)
+	dstInfo := getStructInfo(dst)
+	dstFields := fieldsByName(dstInfo, c.DstFieldNames)
+	copied := make(map[string]bool, len(dstInfo.fields))
+	srcFields := resolveFields(srcInfo, func(field fieldInfo) (string, bool) {
+		return resolveName(field, c.SrcFieldNames)
+	})
+	var whole wholeEmbedded
+	for _, named := range srcFields {
+		srcField := named.field
+		dstField, ok := dstFields[named.name]
+		if whole.skip(srcField, dstField, ok) {
+			continue
+		}
+		unexported := srcField.Unexported || ok && dstField.Unexported
+		if unexported && dst != src {
+			// unexported fields are copied only between values of the same type
+			continue
+		}
+		p.fields = append(p.fields, fieldPlan{src: srcField, dst: dstField, matched: ok, unexported: unexported})
+		p.unexported = p.unexported || unexported
+		if ok {
+			copied[dstField.Name] = true
+		}
+	}
+	for _, dstField := range dstInfo.fields {
+		if dstField.Required && !dstField.Skip && !dstField.Embedded && !copied[dstField.Name] &&
+			!promoted(dstField.Index, whole.dst) {
+			p.required = append(p.required, dstField)
+		}
+	}
+	return p
+}
+
+// wholeEmbedded represents indexes of embedded structs copied as a whole to or from not embedded fields
+type wholeEmbedded struct {
+	src [][]int
+	dst [][]int
+}
+
+// skip reports whether src field isn't copied to dst field: it's promoted from embedded struct copied as a whole
+// or it's embedded struct, which promoted fields are matched instead
+func (w *wholeEmbedded) skip(srcField, dstField fieldInfo, ok bool) bool {
+	switch {
+	case promoted(srcField.Index, w.src):
+		return true
+	case !srcField.Embedded && !(ok && dstField.Embedded):
+		return false
+	case !ok || srcField.Embedded == dstField.Embedded:
+		return true
+	case srcField.Embedded:
+		w.src = append(w.src, srcField.Index)
+	default:
+		w.dst = append(w.dst, dstField.Index)
+	}
+	return false
+}
+
+// promoted reports whether field index is inside one of embedded structs copied as a whole
+func promoted(index []int, embedded [][]int) bool {
+	for _, prefix := range embedded {
+		if len(index) > len(prefix) && reflect.DeepEqual(index[:len(prefix)], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldsByName returns not skipped fields of struct by names resolved with resolver
+func fieldsByName(info *structInfo, resolver FieldNameResolver) map[string]fieldInfo {
+	named := resolveFields(info, func(field fieldInfo) (string, bool) {
+		return resolveName(field, resolver)
+	})
+	fields := make(map[string]fieldInfo, len(named))
+	for _, f := range named {
+		fields[f.name] = f.field
+	}
+	return fields
+}
+
+// namedField represents struct field with resolved name
+type namedField struct {
+	name  string
+	field fieldInfo
+}
+
+// resolveFields returns not skipped fields of struct in order of declaration with names resolved by name function.
+// Fields resolved to the same name hide each other like ambiguous promoted fields in Go.
+func resolveFields(info *structInfo, name func(fieldInfo) (string, bool)) []namedField {
+	fields := make([]namedField, 0, len(info.fields))
+	counts := make(map[string]int, len(info.fields))
+	for _, field := range info.fields {
+		if field.Skip {
+			continue
+		}
+		if n, ok := name(field); ok {
+			fields = append(fields, namedField{name: n, field: field})
+			counts[n]++
+		}
+	}
+	unique := fields[:0]
+	for _, f := range fields {
+		if counts[f.name] == 1 {
+			unique = append(unique, f)
+		}
+	}
+	return unique
+}
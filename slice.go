@@ -0,0 +1,79 @@
+package copier
+
+import (
+	"reflect"
+)
+
+// SliceMode represents the way src slice is copied to existing dst slice
+type SliceMode int
+
+const (
+	// SliceReplace replaces dst with new slice of src length
+	SliceReplace SliceMode = iota
+	// SliceAppend appends copies of src elements to dst elements
+	SliceAppend
+	// SliceMergeByIndex copies src elements onto dst elements with the same index,
+	// extra dst elements are preserved
+	SliceMergeByIndex
+)
+
+// EmptySlicePolicy represents the way nil and empty src slices are copied with SliceReplace mode
+type EmptySlicePolicy int
+
+const (
+	// EmptySlicePreserve copies nil slice to nil slice and empty slice to empty slice
+	EmptySlicePreserve EmptySlicePolicy = iota
+	// EmptySliceAsNil copies both nil and empty slices to nil slice
+	EmptySliceAsNil
+	// NilSliceAsEmpty copies both nil and empty slices to empty slice
+	NilSliceAsEmpty
+)
+
+func (c *Copier) copyToSlice(st *state, dst, src reflect.Value) error {
+	if src.Len() == 0 {
+		c.copyEmptySlice(dst, src)
+		return nil
+	}
+	offset := 0
+	var slice reflect.Value
+	switch c.Slices {
+	case SliceAppend:
+		offset = dst.Len()
+		slice = reflect.MakeSlice(dst.Type(), offset+src.Len(), offset+src.Len())
+		reflect.Copy(slice, dst)
+	case SliceMergeByIndex:
+		length := dst.Len()
+		if src.Len() > length {
+			length = src.Len()
+		}
+		slice = reflect.MakeSlice(dst.Type(), length, length)
+		reflect.Copy(slice, dst)
+	default:
+		slice = reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+	}
+	for i := 0; i < src.Len(); i++ {
+		st.pushIndex(i)
+		err := c.copyInterface(st, slice.Index(offset+i), src.Index(i))
+		st.pop()
+		if err != nil {
+			return err
+		}
+	}
+	dst.Set(slice)
+	return nil
+}
+
+// copyEmptySlice sets nil or empty slice to dst according to EmptySlices policy,
+// dst isn't changed with SliceAppend and SliceMergeByIndex modes
+func (c *Copier) copyEmptySlice(dst, src reflect.Value) {
+	if c.Slices != SliceReplace {
+		return
+	}
+	isNil := src.Kind() == reflect.Slice && src.IsNil()
+	switch {
+	case c.EmptySlices == EmptySliceAsNil, isNil && c.EmptySlices != NilSliceAsEmpty:
+		dst.Set(reflect.Zero(dst.Type()))
+	default:
+		dst.Set(reflect.MakeSlice(dst.Type(), 0, 0))
+	}
+}
@@ -0,0 +1,115 @@
+package copier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Copy_SliceReplaceShorterSrc(t *testing.T) {
+	var dst = []int{1, 2, 3, 4, 5}
+	var src = []string{"100", "200"}
+	err := Copy(&dst, &src, StringToIntConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{100, 200}, dst)
+}
+
+func Test_Copy_SliceReplaceDoesNotShareDstArray(t *testing.T) {
+	backing := []string{"Lorem", "ipsum", "dolor"}
+	var dst = backing[:2]
+	var src = []string{"sit", "amet"}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"sit", "amet"}, dst)
+	assert.Equal(t, []string{"Lorem", "ipsum", "dolor"}, backing)
+}
+
+func Test_Copy_SliceReplaceNilAndEmpty(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy EmptySlicePolicy
+		src    []string
+		result []string
+	}{
+		{name: "preserve nil", policy: EmptySlicePreserve, src: nil, result: nil},
+		{name: "preserve empty", policy: EmptySlicePreserve, src: []string{}, result: []string{}},
+		{name: "empty as nil", policy: EmptySliceAsNil, src: []string{}, result: nil},
+		{name: "nil as empty", policy: NilSliceAsEmpty, src: nil, result: []string{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var dst = []string{"Lorem"}
+			copier := New()
+			copier.EmptySlices = tc.policy
+			err := copier.Copy(&dst, &tc.src)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.result, dst)
+		})
+	}
+}
+
+func Test_Copy_SliceAppend(t *testing.T) {
+	type A struct {
+		Values []string
+	}
+	type B struct {
+		Values []int
+	}
+	var dst = B{Values: []int{1, 2}}
+	var src = A{Values: []string{"100", "200"}}
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	copier.Slices = SliceAppend
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Values: []int{1, 2, 100, 200}}, dst)
+
+	src.Values = nil
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Values: []int{1, 2, 100, 200}}, dst)
+}
+
+func Test_Copy_SliceAppendError(t *testing.T) {
+	var dst = []int{1}
+	var src = []string{"100", "Lorem"}
+	copier := New()
+	copier.SetConverters([]Converter{StringToIntConverter})
+	copier.Slices = SliceAppend
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, `[1]: strconv.Atoi: parsing "Lorem": invalid syntax`)
+	assert.Equal(t, []int{1}, dst)
+}
+
+func Test_Copy_SliceMergeByIndex(t *testing.T) {
+	type Item struct {
+		Name  string
+		Price int
+	}
+	var dst = []Item{{Name: "Lorem", Price: 100}, {Name: "ipsum", Price: 200}, {Name: "dolor", Price: 300}}
+	var src = []Item{{Price: 150}, {Name: "amet"}}
+	copier := New()
+	copier.Slices = SliceMergeByIndex
+	copier.Merge = MergeSkipZero
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, []Item{{Name: "Lorem", Price: 150}, {Name: "amet", Price: 200}, {Name: "dolor", Price: 300}}, dst)
+}
+
+func Test_Copy_SliceMergeByIndexLongerSrc(t *testing.T) {
+	var dst = []string{"Lorem"}
+	var src = []string{"ipsum", "dolor"}
+	copier := New()
+	copier.Slices = SliceMergeByIndex
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ipsum", "dolor"}, dst)
+}
+
+func Test_Copy_ArrayToSlice(t *testing.T) {
+	var dst []int
+	var src = [3]string{"100", "200", "300"}
+	err := Copy(&dst, &src, StringToIntConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{100, 200, 300}, dst)
+}
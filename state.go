@@ -0,0 +1,122 @@
+package copier
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// pathElem represents single step of path to the copied value:
+// struct field name, slice or array index, or map key
+type pathElem struct {
+	field string
+	index int
+	key   reflect.Value
+}
+
+// visitKey represents src pointer copied to dst type
+type visitKey struct {
+	ptr uintptr
+	src reflect.Type
+	dst reflect.Type
+}
+
+// state represents state of a single copy operation
+type state struct {
+	path      []pathElem
+	unmatched []string
+	errs      []error
+	visited   map[visitKey]reflect.Value
+	entered   map[visitKey]bool
+	depth     int
+}
+
+// visit returns dst pointer already made for src pointer
+func (s *state) visit(dst, src reflect.Value) (reflect.Value, bool) {
+	ptr, ok := s.visited[visitKey{ptr: src.Pointer(), src: src.Type(), dst: dst.Type()}]
+	return ptr, ok
+}
+
+// remember saves dst pointer made for src pointer, so cycles and shared pointers are preserved
+func (s *state) remember(dst, src reflect.Value) {
+	if s.visited == nil {
+		s.visited = make(map[visitKey]reflect.Value)
+	}
+	s.visited[visitKey{ptr: src.Pointer(), src: src.Type(), dst: dst.Type()}] = dst
+}
+
+// enter marks src pointers as being copied to map, false is returned if any of them is already entered
+func (s *state) enter(ptrs []visitKey) bool {
+	if s.entered == nil {
+		s.entered = make(map[visitKey]bool)
+	}
+	for _, ptr := range ptrs {
+		if s.entered[ptr] {
+			return false
+		}
+	}
+	for _, ptr := range ptrs {
+		s.entered[ptr] = true
+	}
+	return true
+}
+
+// leave unmarks src pointers marked by enter
+func (s *state) leave(ptrs []visitKey) {
+	for _, ptr := range ptrs {
+		delete(s.entered, ptr)
+	}
+}
+
+func (s *state) pushField(name string) {
+	s.path = append(s.path, pathElem{field: name})
+}
+
+func (s *state) pushIndex(i int) {
+	s.path = append(s.path, pathElem{index: i})
+}
+
+func (s *state) pushKey(key reflect.Value) {
+	s.path = append(s.path, pathElem{key: key})
+}
+
+func (s *state) pop() {
+	s.path = s.path[:len(s.path)-1]
+}
+
+// currentPath returns current path in format `Orders[3].Items["sku"].Price`
+func (s *state) currentPath() string {
+	var b strings.Builder
+	for _, elem := range s.path {
+		switch {
+		case elem.key.IsValid():
+			b.WriteByte('[')
+			if elem.key.Kind() == reflect.String {
+				b.WriteString(strconv.Quote(elem.key.String()))
+			} else {
+				fmt.Fprint(&b, elem.key.Interface())
+			}
+			b.WriteByte(']')
+		case elem.field != "":
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(elem.field)
+		default:
+			b.WriteByte('[')
+			b.WriteString(strconv.Itoa(elem.index))
+			b.WriteByte(']')
+		}
+	}
+	return b.String()
+}
+
+// wrap wraps err to CopyError with current path, errors which already contain path are returned as is
+func (s *state) wrap(err error, dst, src reflect.Type) error {
+	switch err.(type) {
+	case *CopyError, *FieldNotFoundError:
+		return err
+	}
+	return &CopyError{Path: s.currentPath(), SrcType: src, DstType: dst, Err: err}
+}
@@ -0,0 +1,135 @@
+package copier
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// copyMapToStruct copies values of map with string keys to struct fields matched by name or copier tag,
+// keys of primitive.M are matched by bson tags. DstFieldNames resolves names of fields if it's set.
+func (c *Copier) copyMapToStruct(st *state, dst, src reflect.Value) error {
+	keyType := src.Type().Key()
+	if keyType.Kind() != reflect.String {
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, src.Type(), dst.Type())
+	}
+	fields := mapFields(getStructInfo(dst.Type()), src.Type(), c.DstFieldNames)
+	matched := make(map[string]bool, len(fields))
+	for _, named := range fields {
+		field := named.field
+		matched[named.name] = true
+		st.pushField(field.Name)
+		err := c.copyMapIndexToField(st, field, dst, src, reflect.ValueOf(named.name).Convert(keyType))
+		st.pop()
+		if err != nil {
+			return err
+		}
+	}
+	if c.UnmatchedFields == UnmatchedFieldIgnore {
+		return nil
+	}
+	for _, key := range src.MapKeys() {
+		if matched[key.String()] {
+			continue
+		}
+		st.pushKey(key)
+		err := c.unmatchedField(st, fieldInfo{Name: key.String(), Type: src.Type().Elem()})
+		if err != nil {
+			err = c.fail(st, err)
+		}
+		st.pop()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyMapIndexToField copies map value with key of field to struct field
+func (c *Copier) copyMapIndexToField(st *state, field fieldInfo, dst, src, key reflect.Value) error {
+	value := src.MapIndex(key)
+	if !value.IsValid() {
+		if field.Required {
+			return c.fail(st, st.wrap(ErrRequiredField, field.Type, src.Type()))
+		}
+		return nil
+	}
+	if c.skipMerge(value, field.OmitEmpty) {
+		return nil
+	}
+	dstValue, err := allocFieldByIndex(dst, field.Index)
+	if err != nil {
+		return c.fail(st, st.wrap(err, dst.Type(), src.Type()))
+	}
+	return c.copyInterface(st, dstValue, value)
+}
+
+// copyStructToMap copies struct fields to map with string keys of field names or copier tags,
+// keys of primitive.M are taken from bson tags. SrcFieldNames resolves keys if it's set.
+// Nested structs except atomic ones are copied to maps of the same type if map value type is interface.
+func (c *Copier) copyStructToMap(st *state, dst, src reflect.Value) error {
+	keyType := dst.Type().Key()
+	if keyType.Kind() != reflect.String {
+		return fmt.Errorf("%w: expected %s, actual %s", ErrDifferentTypes, src.Type(), dst.Type())
+	}
+	c.prepareMap(dst, 0)
+	for _, named := range mapFields(getStructInfo(src.Type()), dst.Type(), c.SrcFieldNames) {
+		field := named.field
+		value, ok := fieldByIndex(src, field.Index)
+		if !ok || c.skipMerge(value, field.OmitEmpty) {
+			continue
+		}
+		key := reflect.ValueOf(named.name).Convert(keyType)
+		existing := dst.MapIndex(key)
+		if existing.IsValid() && c.Maps == MapMergeKeepExisting {
+			continue
+		}
+		st.pushField(field.Name)
+		errCount := len(st.errs)
+		value, err := c.copyMapValue(st, dst.Type(), dst.Type().Elem(), value, existing)
+		st.pop()
+		if err != nil {
+			return err
+		}
+		if len(st.errs) > errCount {
+			continue
+		}
+		dst.SetMapIndex(key, value)
+	}
+	return nil
+}
+
+// copyMapValue makes copy of struct field value for element of elemType, nested structs are copied
+// to values of docType if elemType is interface. src is copied onto existing value in MapDeepMerge mode.
+func (c *Copier) copyMapValue(st *state, docType, elemType reflect.Type, src, existing reflect.Value) (reflect.Value, error) {
+	target := elemType
+	nested := src
+	var ptrs []visitKey
+	for nested.Kind() == reflect.Ptr || nested.Kind() == reflect.Interface {
+		if nested.IsNil() {
+			break
+		}
+		if nested.Kind() == reflect.Ptr {
+			ptrs = append(ptrs, visitKey{ptr: nested.Pointer(), src: nested.Type(), dst: docType})
+		}
+		nested = nested.Elem()
+	}
+	if elemType.Kind() == reflect.Interface && nested.Kind() == reflect.Struct && !isAtomic(nested.Type()) && docType.AssignableTo(elemType) {
+		// struct is copied to new map, so pointer cycle would never end
+		if !st.enter(ptrs) {
+			return reflect.Value{}, c.fail(st, st.wrap(ErrCycle, docType, src.Type()))
+		}
+		defer st.leave(ptrs)
+		target, src = docType, nested
+	}
+	value := reflect.New(target).Elem()
+	if existing.IsValid() && c.Maps == MapDeepMerge {
+		if target != elemType {
+			existing = existing.Elem()
+		}
+		if existing.IsValid() && existing.Type() == target {
+			value.Set(existing)
+		}
+	}
+	err := c.copyInterface(st, value, src)
+	return value, err
+}
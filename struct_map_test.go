@@ -0,0 +1,174 @@
+package copier
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testAddress struct {
+	City   string
+	Street string `copier:"street"`
+}
+
+type testCustomer struct {
+	ID       int
+	Name     string `copier:"name"`
+	Tags     []string
+	Address  testAddress
+	Previous *testAddress
+	Password string `copier:"-"`
+}
+
+func Test_Copy_MapToStruct(t *testing.T) {
+	var src map[string]interface{}
+	err := json.Unmarshal([]byte(`{
+		"ID": 100,
+		"name": "Jonh",
+		"Tags": ["Lorem", "ipsum"],
+		"Address": {"City": "Kyiv", "street": "Khreshchatyk"},
+		"Previous": {"City": "Lviv"},
+		"Password": "secret"
+	}`), &src)
+	assert.NoError(t, err)
+	var dst testCustomer
+	copier := New()
+	copier.ConvertNumbers = true
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, testCustomer{
+		ID:       100,
+		Name:     "Jonh",
+		Tags:     []string{"Lorem", "ipsum"},
+		Address:  testAddress{City: "Kyiv", Street: "Khreshchatyk"},
+		Previous: &testAddress{City: "Lviv"},
+	}, dst)
+}
+
+func Test_Copy_MapToStructWithConverter(t *testing.T) {
+	type A struct {
+		ID   int
+		Name string
+	}
+	var dst A
+	var src = map[string]string{"ID": "100", "Name": "Jonh"}
+	err := Copy(&dst, &src, StringToIntConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, A{ID: 100, Name: "Jonh"}, dst)
+}
+
+func Test_Copy_MapToStructUnmatchedKeys(t *testing.T) {
+	type A struct {
+		Name string
+	}
+	var dst A
+	var src = map[string]string{"Name": "Jonh", "Type": "skipped"}
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldFail
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, `field not found: ["Type"]`)
+	assert.Equal(t, A{Name: "Jonh"}, dst)
+}
+
+func Test_Copy_MapToStructRequiredField(t *testing.T) {
+	type A struct {
+		ID   string `copier:",required"`
+		Name string
+	}
+	var dst A
+	var src = map[string]string{"Name": "Jonh"}
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "ID: required field not found")
+	assert.True(t, errors.Is(err, ErrRequiredField))
+}
+
+func Test_Copy_MapToStructInvalidValue(t *testing.T) {
+	var dst testCustomer
+	var src = map[string]interface{}{"Address": map[string]interface{}{"City": 100}}
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "Address.City: src and dst fields has different types: expected int, actual string")
+}
+
+func Test_Copy_MapWithIntKeysToStruct(t *testing.T) {
+	var dst testAddress
+	var src = map[int]string{1: "Kyiv"}
+	err := Copy(&dst, &src)
+	assert.EqualError(t, err, "src and dst fields has different types: expected map[int]string, actual copier.testAddress")
+}
+
+func Test_Copy_StructToMap(t *testing.T) {
+	var dst map[string]interface{}
+	var src = testCustomer{
+		ID:       100,
+		Name:     "Jonh",
+		Tags:     []string{"Lorem"},
+		Address:  testAddress{City: "Kyiv", Street: "Khreshchatyk"},
+		Previous: &testAddress{City: "Lviv"},
+		Password: "secret",
+	}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"ID":       100,
+		"name":     "Jonh",
+		"Tags":     []string{"Lorem"},
+		"Address":  map[string]interface{}{"City": "Kyiv", "street": "Khreshchatyk"},
+		"Previous": map[string]interface{}{"City": "Lviv", "street": ""},
+	}, dst)
+}
+
+func Test_Copy_StructToTypedMap(t *testing.T) {
+	type A struct {
+		ID   int
+		Name string
+	}
+	var dst map[string]string
+	var src = A{ID: 100, Name: "Jonh"}
+	err := Copy(&dst, &src, IntToStringConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"ID": "100", "Name": "Jonh"}, dst)
+}
+
+func Test_Copy_StructToMapRoundTrip(t *testing.T) {
+	var src = testCustomer{
+		ID:       100,
+		Name:     "Jonh",
+		Address:  testAddress{City: "Kyiv"},
+		Previous: &testAddress{City: "Lviv"},
+	}
+	tmp, err := CopyTo[map[string]interface{}](src)
+	assert.NoError(t, err)
+	dst, err := CopyTo[testCustomer](tmp)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+}
+
+func Test_Copy_StructToMapPointerCycle(t *testing.T) {
+	node := &testNodeA{Value: "first"}
+	node.Next = node
+	var dstMap map[string]interface{}
+	err := Copy(&dstMap, node)
+	assert.True(t, errors.Is(err, ErrCycle))
+	var copyErr *CopyError
+	assert.True(t, errors.As(err, &copyErr))
+	assert.Equal(t, "Next.Next", copyErr.Path)
+	var dstM primitive.M
+	err = Copy(&dstM, node)
+	assert.True(t, errors.Is(err, ErrCycle))
+	var dstD primitive.D
+	err = Copy(&dstD, node)
+	assert.True(t, errors.Is(err, ErrCycle))
+}
+
+func Test_Copy_StructToMapSharedPointer(t *testing.T) {
+	shared := &testNodeA{Value: "shared"}
+	src := testNodeA{Value: "root", Next: shared, Parent: shared}
+	var dst map[string]interface{}
+	err := Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, "shared", dst["Next"].(map[string]interface{})["Value"])
+	assert.Equal(t, dst["Next"], dst["Parent"])
+}
@@ -0,0 +1,209 @@
+package copier
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+const (
+	// tagName is the struct tag key read by Copier
+	tagName = "copier"
+	// tagSkip is the tag value which excludes field from copying
+	tagSkip = "-"
+	// tagRequired is the tag option which marks field as required
+	tagRequired = "required"
+	// tagOmitEmpty is the tag option which skips copying of zero src value
+	tagOmitEmpty = "omitempty"
+	// bsonTagName is the struct tag key which defines keys of fields in BSON documents
+	bsonTagName = "bson"
+)
+
+// fieldInfo represents copier options of a single struct field
+type fieldInfo struct {
+	Index      []int
+	Name       string
+	Type       reflect.Type
+	Skip       bool
+	Required   bool
+	Renamed    bool
+	Unexported bool
+	OmitEmpty  bool
+	// Embedded reports whether field is flattened embedded struct, which is matched by its type name
+	// only with not embedded field like in Go, its promoted fields are matched otherwise
+	Embedded bool
+	// BSONName is the key of field in BSON documents, empty if field is skipped by bson tag
+	BSONName string
+	// Field is the original struct field passed to FieldNameResolver
+	Field reflect.StructField
+}
+
+// structInfo represents copier options of all fields of struct type
+type structInfo struct {
+	fields []fieldInfo
+	byName map[string]int
+}
+
+// structInfoCache contains parsed structInfo for every visited struct type
+var structInfoCache sync.Map
+
+// resetStructInfo drops all parsed structInfo, so struct tags are parsed again
+func resetStructInfo() {
+	structInfoCache.Range(func(key, _ interface{}) bool {
+		structInfoCache.Delete(key)
+		return true
+	})
+}
+
+// getStructInfo returns parsed structInfo of struct type, tags are parsed once per type.
+// Fields of embedded structs are promoted like Go does it: the shallowest field wins
+// and fields with the same name at the same depth hide each other.
+func getStructInfo(t reflect.Type) *structInfo {
+	if info, ok := structInfoCache.Load(t); ok {
+		return info.(*structInfo)
+	}
+	var fields []fieldInfo
+	collectFields(t, nil, map[reflect.Type]bool{t: true}, &fields)
+	depths := make(map[string]int, len(fields))
+	counts := make(map[string]int, len(fields))
+	for _, field := range fields {
+		if field.Skip {
+			continue
+		}
+		depth, ok := depths[field.Name]
+		switch {
+		case !ok || len(field.Index) < depth:
+			depths[field.Name] = len(field.Index)
+			counts[field.Name] = 1
+		case len(field.Index) == depth:
+			counts[field.Name]++
+		}
+	}
+	info := &structInfo{
+		fields: make([]fieldInfo, 0, len(fields)),
+		byName: make(map[string]int, len(fields)),
+	}
+	for _, field := range fields {
+		if !field.Skip {
+			if len(field.Index) != depths[field.Name] || counts[field.Name] > 1 {
+				continue
+			}
+			info.byName[field.Name] = len(info.fields)
+		}
+		info.fields = append(info.fields, field)
+	}
+	actual, _ := structInfoCache.LoadOrStore(t, info)
+	return actual.(*structInfo)
+}
+
+// collectFields appends fields of struct type t to fields, embedded structs are flattened
+func collectFields(t reflect.Type, index []int, visited map[reflect.Type]bool, fields *[]fieldInfo) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		field := parseField(sf)
+		field.Index = make([]int, len(index)+1)
+		copy(field.Index, index)
+		field.Index[len(index)] = i
+		embedded := embeddedStruct(sf)
+		if embedded != nil && !field.Skip && !field.Renamed && !visited[embedded] {
+			field.Embedded = true
+			*fields = append(*fields, field)
+			visited[embedded] = true
+			collectFields(embedded, field.Index, visited, fields)
+			delete(visited, embedded)
+			continue
+		}
+		*fields = append(*fields, field)
+	}
+}
+
+// embeddedStruct returns struct type of embedded struct or pointer to struct field, atomic structs are not flattened
+func embeddedStruct(sf reflect.StructField) reflect.Type {
+	if !sf.Anonymous {
+		return nil
+	}
+	t := sf.Type
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || isAtomic(t) {
+		return nil
+	}
+	return t
+}
+
+// field returns not skipped field matched by name
+func (s *structInfo) field(name string) (fieldInfo, bool) {
+	i, ok := s.byName[name]
+	if !ok {
+		return fieldInfo{}, false
+	}
+	return s.fields[i], true
+}
+
+// parseField parses copier tag of struct field.
+// Supported formats: `copier:"-"`, `copier:"Name"`, `copier:"Name,required"`, `copier:",required,omitempty"`.
+func parseField(sf reflect.StructField) fieldInfo {
+	field := fieldInfo{Name: sf.Name, Type: sf.Type, Unexported: !sf.IsExported(), BSONName: bsonName(sf), Field: sf}
+	tag, ok := sf.Tag.Lookup(tagName)
+	if !ok {
+		return field
+	}
+	if tag == tagSkip {
+		field.Skip = true
+		return field
+	}
+	parts := strings.Split(tag, ",")
+	if name := strings.TrimSpace(parts[0]); name != "" {
+		field.Name = name
+		field.Renamed = true
+	}
+	for _, opt := range parts[1:] {
+		switch strings.TrimSpace(opt) {
+		case tagRequired:
+			field.Required = true
+		case tagOmitEmpty:
+			field.OmitEmpty = true
+		}
+	}
+	return field
+}
+
+// bsonName returns key of field in BSON documents: name from bson tag or lowercased field name like mongo driver does
+func bsonName(sf reflect.StructField) string {
+	tag := sf.Tag.Get(bsonTagName)
+	if tag == tagSkip {
+		return ""
+	}
+	if name := strings.Split(tag, ",")[0]; name != "" {
+		return name
+	}
+	return strings.ToLower(sf.Name)
+}
+
+// FieldNameResolver returns name used to match struct field with fields of other struct,
+// false means the field isn't matched at all
+type FieldNameResolver func(sf reflect.StructField) (string, bool)
+
+// TagNameResolver returns FieldNameResolver which takes name from struct tag with given key, e.g. "json" or "bson".
+// Go field name is used if tag or its name is empty, fields tagged with "-" aren't matched.
+func TagNameResolver(key string) FieldNameResolver {
+	return func(sf reflect.StructField) (string, bool) {
+		tag := sf.Tag.Get(key)
+		if tag == tagSkip {
+			return "", false
+		}
+		if name := strings.Split(tag, ",")[0]; name != "" {
+			return name, true
+		}
+		return sf.Name, true
+	}
+}
+
+// resolveName returns name of field to match: copier tag name takes precedence over resolver
+func resolveName(field fieldInfo, resolver FieldNameResolver) (string, bool) {
+	if resolver == nil || field.Renamed {
+		return field.Name, true
+	}
+	return resolver(field.Field)
+}
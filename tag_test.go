@@ -0,0 +1,134 @@
+package copier
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testAccountEntity struct {
+	ID       primitive.ObjectID `bson:"_id"`
+	Email    string             `bson:"email_address"`
+	FullName string             `bson:"full_name"`
+	Password string             `bson:"password"`
+	Age      int
+}
+
+type testAccountResponse struct {
+	ID       string `json:"id" copier:"_id"`
+	Email    string `json:"email_address"`
+	Name     string `json:"full_name"`
+	Password string `json:"-"`
+	Age      int
+}
+
+func Test_Copy_TagNameResolvers(t *testing.T) {
+	var src = testAccountEntity{
+		ID:       primitive.NewObjectID(),
+		Email:    "jonh@example.com",
+		FullName: "Jonh",
+		Password: "secret",
+		Age:      30,
+	}
+	var dst testAccountResponse
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldReport
+	copier.SetConverters([]Converter{*ObjectIDToStringConverter})
+	copier.SetFieldNameResolvers(TagNameResolver("bson"), TagNameResolver("json"))
+	err := copier.Copy(&dst, &src)
+	assert.EqualError(t, err, "field not found: Password")
+	assert.Equal(t, testAccountResponse{ID: src.ID.Hex(), Email: "jonh@example.com", Name: "Jonh", Age: 30}, dst)
+}
+
+func Test_Copy_CopierTagTakesPrecedenceOverResolver(t *testing.T) {
+	type A struct {
+		Title string `json:"name" copier:"Title"`
+		Body  string `json:"Text"`
+	}
+	type B struct {
+		Name  string
+		Title string
+		Text  string
+	}
+	var dst B
+	var src = A{Title: "Lorem", Body: "ipsum"}
+	copier := New()
+	copier.SetFieldNameResolvers(TagNameResolver("json"), nil)
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Title: "Lorem", Text: "ipsum"}, dst)
+}
+
+func Test_Copy_CustomFieldNameResolver(t *testing.T) {
+	type A struct {
+		UserName string
+	}
+	type B struct {
+		Name string
+	}
+	var dst B
+	var src = A{UserName: "Jonh"}
+	copier := New()
+	copier.SetFieldNameResolvers(func(sf reflect.StructField) (string, bool) {
+		return "Name", sf.Name == "UserName"
+	}, nil)
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, B{Name: "Jonh"}, dst)
+}
+
+func Test_Copy_FieldNameResolversResetPlans(t *testing.T) {
+	var src = testAccountEntity{FullName: "Jonh"}
+	var dst testAccountResponse
+	copier := New()
+	copier.UnmatchedFields = UnmatchedFieldIgnore
+	copier.SetConverters([]Converter{*ObjectIDToStringConverter})
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, "", dst.Name)
+
+	copier.SetFieldNameResolvers(TagNameResolver("bson"), TagNameResolver("json"))
+	err = copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, "Jonh", dst.Name)
+}
+
+func Test_Copy_AmbiguousResolvedNames(t *testing.T) {
+	type A struct {
+		Name string
+	}
+	type B struct {
+		First  string `api:"Name"`
+		Second string `api:"Name"`
+		Name   string `api:"-"`
+	}
+	copier := New()
+	copier.SetFieldNameResolvers(nil, TagNameResolver("api"))
+	for i := 0; i < 20; i++ {
+		var dst B
+		err := copier.Copy(&dst, &A{Name: "Jonh"})
+		assert.NoError(t, err)
+		assert.Equal(t, B{}, dst)
+	}
+}
+
+func Test_Copy_MapToStructWithResolver(t *testing.T) {
+	type A struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	var dst A
+	var src = map[string]interface{}{"id": 1, "name": "Jonh"}
+	copier := New()
+	copier.SetFieldNameResolvers(TagNameResolver("json"), TagNameResolver("json"))
+	err := copier.Copy(&dst, &src)
+	assert.NoError(t, err)
+	assert.Equal(t, A{ID: 1, Name: "Jonh"}, dst)
+
+	var m map[string]interface{}
+	err = copier.Copy(&m, &dst)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"id": 1, "name": "Jonh"}, m)
+}
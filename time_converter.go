@@ -0,0 +1,148 @@
+package copier
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// TimeConverters represents set of time converters with configurable layout and location
+type TimeConverters struct {
+	// Layout is used to format and parse time strings, time.RFC3339 if empty
+	Layout string
+	// Location of converted times, time.UTC if nil
+	Location *time.Location
+}
+
+var (
+	// TimeToStringConverter is converter for copier,
+	// which realize time.Time to RFC3339 string convertation.
+	TimeToStringConverter = TimeConverters{}.TimeToString()
+
+	// StringToTimeConverter is converter for copier,
+	// which realize RFC3339 string to time.Time convertation.
+	StringToTimeConverter = TimeConverters{}.StringToTime()
+
+	// TimeToDateTimeConverter is converter for copier,
+	// which realize time.Time to mongo DateTime convertation.
+	TimeToDateTimeConverter = TimeConverters{}.TimeToDateTime()
+
+	// DateTimeToTimeConverter is converter for copier,
+	// which realize mongo DateTime to UTC time.Time convertation.
+	DateTimeToTimeConverter = TimeConverters{}.DateTimeToTime()
+
+	// DurationToStringConverter is converter for copier,
+	// which realize time.Duration to string convertation, e.g. "1h30m0s".
+	DurationToStringConverter = NewConverter(func(src time.Duration) (string, error) {
+		return src.String(), nil
+	})
+
+	// StringToDurationConverter is converter for copier,
+	// which realize string to time.Duration convertation.
+	StringToDurationConverter = NewConverter(func(src string) (time.Duration, error) {
+		if src == "" {
+			return 0, nil
+		}
+		d, err := time.ParseDuration(src)
+		if err != nil {
+			return 0, fmt.Errorf("failed get duration from string: %v", err)
+		}
+		return d, nil
+	})
+)
+
+// Converters returns time.Time to string, mongo DateTime and back converters with duration converters.
+// Unix time converters aren't included, because seconds and milliseconds are both int64.
+func (tc TimeConverters) Converters() []Converter {
+	return []Converter{
+		tc.TimeToString(),
+		tc.StringToTime(),
+		tc.TimeToDateTime(),
+		tc.DateTimeToTime(),
+		DurationToStringConverter,
+		StringToDurationConverter,
+	}
+}
+
+// TimeToString returns converter of time.Time to string formatted with Layout in Location,
+// zero time is converted to empty string
+func (tc TimeConverters) TimeToString() Converter {
+	return NewConverter(func(src time.Time) (string, error) {
+		if src.IsZero() {
+			return "", nil
+		}
+		return src.In(tc.location()).Format(tc.layout()), nil
+	})
+}
+
+// StringToTime returns converter of string parsed with Layout to time.Time in Location,
+// empty string is converted to zero time
+func (tc TimeConverters) StringToTime() Converter {
+	return NewConverter(func(src string) (time.Time, error) {
+		if src == "" {
+			return time.Time{}, nil
+		}
+		t, err := time.ParseInLocation(tc.layout(), src, tc.location())
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed get time from string: %v", err)
+		}
+		return t.In(tc.location()), nil
+	})
+}
+
+// TimeToUnix returns converter of time.Time to Unix time in seconds
+func (tc TimeConverters) TimeToUnix() Converter {
+	return NewConverter(func(src time.Time) (int64, error) {
+		return src.Unix(), nil
+	})
+}
+
+// UnixToTime returns converter of Unix time in seconds to time.Time in Location
+func (tc TimeConverters) UnixToTime() Converter {
+	return NewConverter(func(src int64) (time.Time, error) {
+		return time.Unix(src, 0).In(tc.location()), nil
+	})
+}
+
+// TimeToUnixMilli returns converter of time.Time to Unix time in milliseconds
+func (tc TimeConverters) TimeToUnixMilli() Converter {
+	return NewConverter(func(src time.Time) (int64, error) {
+		return src.UnixMilli(), nil
+	})
+}
+
+// UnixMilliToTime returns converter of Unix time in milliseconds to time.Time in Location
+func (tc TimeConverters) UnixMilliToTime() Converter {
+	return NewConverter(func(src int64) (time.Time, error) {
+		return time.UnixMilli(src).In(tc.location()), nil
+	})
+}
+
+// TimeToDateTime returns converter of time.Time to mongo DateTime, precision is truncated to milliseconds
+func (tc TimeConverters) TimeToDateTime() Converter {
+	return NewConverter(func(src time.Time) (primitive.DateTime, error) {
+		return primitive.NewDateTimeFromTime(src), nil
+	})
+}
+
+// DateTimeToTime returns converter of mongo DateTime to time.Time in Location
+func (tc TimeConverters) DateTimeToTime() Converter {
+	return NewConverter(func(src primitive.DateTime) (time.Time, error) {
+		return src.Time().In(tc.location()), nil
+	})
+}
+
+func (tc TimeConverters) layout() string {
+	if tc.Layout == "" {
+		return time.RFC3339
+	}
+	return tc.Layout
+}
+
+func (tc TimeConverters) location() *time.Location {
+	if tc.Location == nil {
+		return time.UTC
+	}
+	return tc.Location
+}
@@ -0,0 +1,117 @@
+package copier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type testTimeModel struct {
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt primitive.DateTime
+	Timeout   time.Duration
+}
+
+type testTimeDTO struct {
+	Name      string
+	CreatedAt string
+	UpdatedAt time.Time
+	Timeout   string
+}
+
+func Test_TimeConverters_RoundTrip(t *testing.T) {
+	created := time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC)
+	updated := time.Date(2023, 5, 2, 11, 0, 0, 0, time.UTC)
+	converters := TimeConverters{}.Converters()
+	var src = testTimeModel{
+		Name:      "Lorem",
+		CreatedAt: created,
+		UpdatedAt: primitive.NewDateTimeFromTime(updated),
+		Timeout:   90 * time.Minute,
+	}
+	dto, err := CopyTo[testTimeDTO](src, converters...)
+	assert.NoError(t, err)
+	assert.Equal(t, testTimeDTO{
+		Name:      "Lorem",
+		CreatedAt: "2023-05-01T10:30:00Z",
+		UpdatedAt: updated,
+		Timeout:   "1h30m0s",
+	}, dto)
+	dst, err := CopyTo[testTimeModel](dto, converters...)
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+}
+
+func Test_TimeConverters_LayoutAndLocation(t *testing.T) {
+	kyiv := time.FixedZone("EEST", 3*60*60)
+	tc := TimeConverters{Layout: "2006-01-02 15:04", Location: kyiv}
+	var dst string
+	var src = time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC)
+	err := Copy(&dst, &src, tc.TimeToString())
+	assert.NoError(t, err)
+	assert.Equal(t, "2023-05-01 13:30", dst)
+
+	var parsed time.Time
+	err = Copy(&parsed, &dst, tc.StringToTime())
+	assert.NoError(t, err)
+	assert.True(t, src.Equal(parsed))
+	assert.Equal(t, kyiv, parsed.Location())
+}
+
+func Test_TimeConverters_ZeroTimeAndEmptyString(t *testing.T) {
+	var dst string
+	var src time.Time
+	err := Copy(&dst, &src, TimeToStringConverter)
+	assert.NoError(t, err)
+	assert.Equal(t, "", dst)
+
+	var parsed = time.Now()
+	err = Copy(&parsed, &dst, StringToTimeConverter)
+	assert.NoError(t, err)
+	assert.True(t, parsed.IsZero())
+}
+
+func Test_TimeConverters_InvalidString(t *testing.T) {
+	var dst time.Time
+	var src = "Lorem"
+	err := Copy(&dst, &src, StringToTimeConverter)
+	assert.EqualError(t, err, `failed get time from string: parsing time "Lorem" as "2006-01-02T15:04:05Z07:00": cannot parse "Lorem" as "2006"`)
+}
+
+func Test_TimeConverters_Unix(t *testing.T) {
+	type A struct {
+		CreatedAt time.Time
+	}
+	type B struct {
+		CreatedAt int64
+	}
+	var tc TimeConverters
+	var src = A{CreatedAt: time.Date(2023, 5, 1, 10, 30, 0, 0, time.UTC)}
+	b, err := CopyTo[B](src, tc.TimeToUnix())
+	assert.NoError(t, err)
+	assert.Equal(t, B{CreatedAt: 1682937000}, b)
+	a, err := CopyTo[A](b, tc.UnixToTime())
+	assert.NoError(t, err)
+	assert.Equal(t, src, a)
+}
+
+func Test_TimeConverters_UnixMilli(t *testing.T) {
+	var tc TimeConverters
+	var src = time.Date(2023, 5, 1, 10, 30, 0, int(250*time.Millisecond), time.UTC)
+	millis, err := CopyTo[int64](src, tc.TimeToUnixMilli())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1682937000250), millis)
+	dst, err := CopyTo[time.Time](millis, tc.UnixMilliToTime())
+	assert.NoError(t, err)
+	assert.Equal(t, src, dst)
+}
+
+func Test_TimeConverters_Duration(t *testing.T) {
+	var dst time.Duration
+	var src = "Lorem"
+	err := Copy(&dst, &src, StringToDurationConverter)
+	assert.EqualError(t, err, `failed get duration from string: time: invalid duration "Lorem"`)
+}